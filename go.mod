@@ -1,10 +1,11 @@
 module github.com/Uffe-Code/go-nullable
 
-go 1.18
+go 1.24
+
+require github.com/stretchr/testify v1.7.4
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/stretchr/testify v1.7.4 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
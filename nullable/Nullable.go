@@ -1,7 +1,9 @@
 package nullable
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -45,8 +47,16 @@ func (n Nullable[T]) IsZero() bool {
 	if !n.Valid {
 		return true
 	}
+	if decimal, ok := any(n.Data).(Decimal); ok {
+		return decimal.IsZero()
+	}
+	value := any(n.Data)
+	if value != nil && !reflect.TypeOf(value).Comparable() {
+		// Like byte slices, maps and structs with slices, which can not be compared with ==
+		return reflect.ValueOf(value).IsZero()
+	}
 	var ref T
-	return any(ref) == any(n.Data)
+	return any(ref) == value
 }
 
 // Equal Check if this Nullable is equal to another Nullable
@@ -56,21 +66,55 @@ func (n Nullable[T]) Equal(other Nullable[T]) bool {
 		nValue := any(n.Data).(time.Time)
 		otherValue := any(other.Data).(time.Time)
 		return n.Valid == other.Valid && (!n.Valid || nValue.Equal(otherValue))
+	case Decimal:
+		nValue := any(n.Data).(Decimal)
+		otherValue := any(other.Data).(Decimal)
+		return n.Valid == other.Valid && (!n.Valid || nValue.Equal(otherValue))
 	}
 	return n.ExactEqual(other)
 }
 
 // ExactEqual Check if this Nullable is exact equal to another Nullable, never using intern Equal method to check equality
 func (n Nullable[T]) ExactEqual(other Nullable[T]) bool {
-	return n.Valid == other.Valid && (!n.Valid || any(n.Data) == any(other.Data))
+	return n.Valid == other.Valid && (!n.Valid || dataEqual(n.Data, other.Data))
+}
+
+// dataEqual Check if a and b are equal with ==, or for types that are not comparable, like byte slices and maps,
+// with bytes.Equal and reflect.DeepEqual
+func dataEqual[T any](a, b T) bool {
+	aValue, bValue := any(a), any(b)
+	if aValue == nil || bValue == nil {
+		return aValue == bValue
+	}
+	t := reflect.TypeOf(aValue)
+	if t.Comparable() {
+		return aValue == bValue
+	}
+	if t != reflect.TypeOf(bValue) {
+		return false
+	}
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+		return bytes.Equal(reflect.ValueOf(aValue).Bytes(), reflect.ValueOf(bValue).Bytes())
+	}
+	return reflect.DeepEqual(aValue, bValue)
 }
 
 // String Convert value to string
 func (n Nullable[T]) String() string {
-	return fmt.Sprintf("%s", any(n.Data))
+	if info := lookupEnum[T](); info != nil {
+		if name, ok := info.name(n.Data); ok {
+			return name
+		}
+	}
+	return fmt.Sprintf("%v", any(n.Data))
 }
 
 func (n Nullable[T]) GoString() string {
 	var ref T
 	return fmt.Sprintf("nullable.Nullable[%T]{Data:%#v,Valid:%#v}", ref, n.Data, n.Valid)
 }
+
+// typeOf Get the reflect.Type of T, also when T is an interface type
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
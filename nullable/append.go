@@ -0,0 +1,244 @@
+package nullable
+
+import (
+	"encoding/json"
+	"math"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// AppendText Append the same text as MarshalText to b. A NULL appends nothing.
+// Implements encoding.TextAppender, and does not allocate for built-in types and time.Time
+func (n Nullable[T]) AppendText(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return b, nil
+	}
+
+	plan := planOf[T]()
+	if plan.textCodec == nil && plan.mapping == nil {
+		if text, ok, err := appendTextBuiltIn(b, any(&n.Data), floatFormatOf[T](), plan.nonFinite); ok {
+			return text, err
+		}
+		if text, ok, err := appendTextKnown(b, n.Data); ok {
+			return text, err
+		}
+	}
+
+	text, err := n.MarshalText()
+	if err != nil {
+		return b, err
+	}
+	return append(b, text...), nil
+}
+
+// AppendJSON Append the same JSON as MarshalJSON to b. A NULL appends null.
+// It does not allocate for built-in types and time.Time, and uses encoding/json only for other types
+func (n Nullable[T]) AppendJSON(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return append(b, nullBytes...), nil
+	}
+
+	if plan := planOf[T](); plan.jsonCodec == nil {
+		if data, ok, err := appendJSONBuiltIn(b, any(&n.Data), floatFormatOf[T](), plan.nonFinite, plan.int64JSON); ok {
+			return data, err
+		}
+	}
+
+	data, err := n.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+	return append(b, data...), nil
+}
+
+// appendTextBuiltIn Append the text of data, a pointer to a built-in type or time.Time, to b. Floats are written in format,
+// with non-finite values written by policy. Returns false if data points to another type
+func appendTextBuiltIn(b []byte, data any, format FloatFormat, policy NonFinitePolicy) ([]byte, bool, error) {
+	switch d := data.(type) {
+	case *string:
+		return append(b, *d...), true, nil
+	case *bool:
+		return strconv.AppendBool(b, *d), true, nil
+	case *int:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int8:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int16:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int32:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int64:
+		return strconv.AppendInt(b, *d, 10), true, nil
+	case *float32:
+		text, err := appendTextFloat(b, float64(*d), 32, format, policy)
+		return text, true, err
+	case *float64:
+		text, err := appendTextFloat(b, *d, 64, format, policy)
+		return text, true, err
+	case *json.RawMessage:
+		return append(b, *d...), true, nil
+	case *time.Time:
+		text, err := d.AppendText(b)
+		return text, true, err
+	}
+	return b, false, nil
+}
+
+// appendTextKnown Append the text of data with its AppendText method, for types where it is known to give the same
+// text as MarshalText. Other types go through MarshalText, since a type that embeds a TextAppender, like time.Time,
+// and has its own MarshalText gets the AppendText of the embedded type. Returns false for other types
+func appendTextKnown(b []byte, data any) ([]byte, bool, error) {
+	switch d := data.(type) {
+	case netip.Addr:
+		text, err := d.AppendText(b)
+		return text, true, err
+	case netip.Prefix:
+		text, err := d.AppendText(b)
+		return text, true, err
+	case netip.AddrPort:
+		text, err := d.AppendText(b)
+		return text, true, err
+	case UnixSeconds:
+		text, err := d.AppendText(b)
+		return text, true, err
+	case UnixMillis:
+		text, err := d.AppendText(b)
+		return text, true, err
+	}
+	return b, false, nil
+}
+
+// appendTextFloat Append f as text, with non-finite values written by policy
+func appendTextFloat(b []byte, f float64, bits int, format FloatFormat, policy NonFinitePolicy) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		switch policy {
+		case NonFiniteAsNull:
+			return b, nil
+		case NonFiniteAsString:
+			return append(b, nonFiniteText(f)...), nil
+		case NonFiniteAsError:
+			return b, &NonFiniteError{Value: f}
+		}
+	}
+	return format.appendFloat(b, f, bits), nil
+}
+
+// appendJSONBuiltIn Append data, a pointer to a built-in type or time.Time, to b as JSON, exactly like encoding/json
+// when floats have the ShortestFloat format and integers int64Format Int64AsNumber. Returns false if data points to another type
+func appendJSONBuiltIn(b []byte, data any, format FloatFormat, policy NonFinitePolicy, int64Format Int64JSONFormat) ([]byte, bool, error) {
+	switch d := data.(type) {
+	case *string:
+		return appendJSONString(b, *d), true, nil
+	case *bool:
+		return strconv.AppendBool(b, *d), true, nil
+	case *int:
+		return appendJSONInt(b, int64(*d), strconv.IntSize == 64 && int64Format == Int64AsString), true, nil
+	case *int8:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int16:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int32:
+		return strconv.AppendInt(b, int64(*d), 10), true, nil
+	case *int64:
+		return appendJSONInt(b, *d, int64Format == Int64AsString), true, nil
+	case *Int64String:
+		return appendJSONInt(b, int64(*d), true), true, nil
+	case *float32:
+		data, err := appendJSONFloat(b, float64(*d), 32, format, policy)
+		return data, true, err
+	case *float64:
+		data, err := appendJSONFloat(b, *d, 64, format, policy)
+		return data, true, err
+	case *time.Time:
+		b = append(b, '"')
+		data, err := d.AppendText(b)
+		if err != nil {
+			return b[:len(b)-1], true, err
+		}
+		return append(data, '"'), true, nil
+	}
+	return b, false, nil
+}
+
+// appendJSONFloat Append f as a JSON number in format. Non-finite values are written by policy
+func appendJSONFloat(b []byte, f float64, bits int, format FloatFormat, policy NonFinitePolicy) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		switch policy {
+		case NonFiniteAsNull:
+			return append(b, nullBytes...), nil
+		case NonFiniteAsString:
+			return append(append(append(b, '"'), nonFiniteText(f)...), '"'), nil
+		}
+		return b, &json.UnsupportedValueError{Value: reflect.ValueOf(f), Str: strconv.FormatFloat(f, 'g', -1, bits)}
+	}
+	return format.appendFloat(b, f, bits), nil
+}
+
+// jsonInvalidUTF8 is what encoding/json writes for invalid UTF-8. It is an escape sequence in the original encoder,
+// and the character itself when encoding/json is implemented with encoding/json/v2
+var jsonInvalidUTF8 = func() string {
+	data, err := json.Marshal("\xff")
+	if err != nil || len(data) < 2 {
+		return `\ufffd`
+	}
+	return string(data[1 : len(data)-1])
+}()
+
+// appendJSONString Append s as a JSON string, escaped like encoding/json does with HTML escaping
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, jsonInvalidUTF8...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid JSON, but not valid JavaScript
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
@@ -0,0 +1,116 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func assertAppendJSON[T any](t *testing.T, value T) {
+	expected, err := json.Marshal(value)
+	assert.NoError(t, err)
+
+	data, err := Value(value).AppendJSON([]byte("prefix:"))
+	assert.NoError(t, err)
+	assert.Equal(t, "prefix:"+string(expected), string(data), value)
+
+	data, err = Value(value).MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(data), value)
+}
+
+func Test_AppendJSON_likeEncodingJSON(t *testing.T) {
+	for _, s := range []string{"", "plain", `quote " and \ backslash`, "tab\tnew\nline\r", "\b\f\x00\x1f\x7f",
+		"<html> & more", "åäö 日本", "  ", "invalid \xff utf-8 \xc3", "emoji 😀"} {
+		assertAppendJSON(t, s)
+	}
+	for _, f := range []float64{0, 1, -1.5, 0.1, 1e-6, 1e-7, 123456789, 1e20, 1e21, -1e21, 1.7976931348623157e308,
+		5e-324, math.Copysign(0, -1)} {
+		assertAppendJSON(t, f)
+	}
+	for _, f := range []float32{0, 1.1, 1e-7, 1e21, 3.4028235e38, -0.3} {
+		assertAppendJSON(t, f)
+	}
+	assertAppendJSON(t, true)
+	assertAppendJSON(t, false)
+	assertAppendJSON(t, math.MinInt)
+	assertAppendJSON(t, int8(-128))
+	assertAppendJSON(t, int16(32767))
+	assertAppendJSON(t, int32(-5))
+	assertAppendJSON(t, int64(math.MaxInt64))
+	assertAppendJSON(t, time.Date(2024, 2, 29, 13, 14, 15, 123456000, time.FixedZone("", 3600)))
+	assertAppendJSON(t, address{AddressLine1: "Street 1"})
+	assertAppendJSON(t, netip.MustParseAddr("::1"))
+
+	data, err := Null[int]().AppendJSON([]byte("["))
+	assert.NoError(t, err)
+	assert.Equal(t, "[null", string(data))
+
+	_, err = Value(math.NaN()).AppendJSON(nil)
+	assert.EqualError(t, err, "json: unsupported value: NaN")
+	_, err = Value(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)).AppendJSON(nil)
+	assert.Error(t, err)
+}
+
+func Test_AppendText(t *testing.T) {
+	for _, n := range []interface {
+		AppendText([]byte) ([]byte, error)
+		MarshalText() ([]byte, error)
+	}{
+		Value("text"), Value(true), Value(-42), Value(int8(8)), Value(int64(1) << 40), Value(1.25), Value(float32(0.5)),
+		Value(timeValue1), Value(json.RawMessage(`{}`)), Value(netip.MustParseAddr("10.0.0.1")), Value(90 * time.Second),
+		Value(NewDecimal(1250, 2)), Null[int](), Null[string](),
+		// These embed time.Time, but write their own text
+		Value(UnixSeconds{timeValue1}), Value(UnixMillis{timeValue1}), Value(LayoutTime[DateTimeLayout]{timeValue1}),
+		Value(LayoutTime[RFC1123Layout]{timeValue1}),
+	} {
+		expected, err := n.MarshalText()
+		assert.NoError(t, err)
+
+		text, err := n.AppendText([]byte("prefix:"))
+		assert.NoError(t, err)
+		assert.Equal(t, "prefix:"+string(expected), string(text), n)
+	}
+}
+
+// yearTime embeds time.Time, which has AppendText, but writes only the year as text
+type yearTime struct {
+	time.Time
+}
+
+func (y yearTime) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(y.Year())), nil
+}
+
+func Test_AppendText_embedded(t *testing.T) {
+	text, err := Value(yearTime{timeValue1}).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(timeValue1.Year()), string(text))
+
+	marshalled, err := Value(yearTime{timeValue1}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(marshalled), string(text))
+}
+
+func Test_Append_allocations(t *testing.T) {
+	buf := make([]byte, 0, 128)
+	allocs := map[string]float64{
+		"text int":    testing.AllocsPerRun(100, func() { _, _ = Value(123456).AppendText(buf) }),
+		"text float":  testing.AllocsPerRun(100, func() { _, _ = Value(1234.5).AppendText(buf) }),
+		"text string": testing.AllocsPerRun(100, func() { _, _ = Value("hello").AppendText(buf) }),
+		"text time":   testing.AllocsPerRun(100, func() { _, _ = Value(timeValue1).AppendText(buf) }),
+		"json int":    testing.AllocsPerRun(100, func() { _, _ = Value(int64(123456)).AppendJSON(buf) }),
+		"json bool":   testing.AllocsPerRun(100, func() { _, _ = Value(true).AppendJSON(buf) }),
+		"json float":  testing.AllocsPerRun(100, func() { _, _ = Value(1e-9).AppendJSON(buf) }),
+		"json string": testing.AllocsPerRun(100, func() { _, _ = Value("<hello>\n").AppendJSON(buf) }),
+		"json time":   testing.AllocsPerRun(100, func() { _, _ = Value(timeValue1).AppendJSON(buf) }),
+		"json null":   testing.AllocsPerRun(100, func() { _, _ = Null[int]().AppendJSON(buf) }),
+	}
+	for name, count := range allocs {
+		assert.Zero(t, count, name)
+	}
+}
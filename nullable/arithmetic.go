@@ -0,0 +1,224 @@
+package nullable
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrOverflow is returned when an arithmetic operation or conversion does not fit in the result type
+var ErrOverflow = errors.New("null: numeric overflow")
+
+// Integer is the set of integer types supported by the arithmetic functions
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the set of floating point types supported by the arithmetic functions
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is the set of numeric types supported by the arithmetic functions
+type Number interface {
+	Integer | Float
+}
+
+// Add Get a + b, or NULL if any of them is NULL
+func Add[T Number](a, b Nullable[T]) Nullable[T] {
+	if !a.Valid || !b.Valid {
+		return Null[T]()
+	}
+	return Value(a.Data + b.Data)
+}
+
+// Sub Get a - b, or NULL if any of them is NULL
+func Sub[T Number](a, b Nullable[T]) Nullable[T] {
+	if !a.Valid || !b.Valid {
+		return Null[T]()
+	}
+	return Value(a.Data - b.Data)
+}
+
+// Mul Get a * b, or NULL if any of them is NULL
+func Mul[T Number](a, b Nullable[T]) Nullable[T] {
+	if !a.Valid || !b.Valid {
+		return Null[T]()
+	}
+	return Value(a.Data * b.Data)
+}
+
+// Div Get a / b, or NULL if any of them is NULL. Integer division by zero panics, just like in Go
+func Div[T Number](a, b Nullable[T]) Nullable[T] {
+	if !a.Valid || !b.Valid {
+		return Null[T]()
+	}
+	return Value(a.Data / b.Data)
+}
+
+// DivOrNull Get a / b, or NULL if any of them is NULL or b is zero
+func DivOrNull[T Number](a, b Nullable[T]) Nullable[T] {
+	if !a.Valid || !b.Valid || b.Data == 0 {
+		return Null[T]()
+	}
+	return Value(a.Data / b.Data)
+}
+
+// Neg Get -a, or NULL if a is NULL
+func Neg[T Number](a Nullable[T]) Nullable[T] {
+	if !a.Valid {
+		return Null[T]()
+	}
+	return Value(-a.Data)
+}
+
+// Abs Get the absolute value of a, or NULL if a is NULL
+func Abs[T Number](a Nullable[T]) Nullable[T] {
+	if !a.Valid {
+		return Null[T]()
+	}
+	if a.Data < 0 {
+		return Value(-a.Data)
+	}
+	return a
+}
+
+// Pow Get base raised to exp, or NULL if any of them is NULL.
+// Integer types use integer exponentiation, where negative exponents truncate towards zero
+func Pow[T Number](base, exp Nullable[T]) Nullable[T] {
+	if !base.Valid || !exp.Valid {
+		return Null[T]()
+	}
+	if isFloat[T]() {
+		return Value(T(math.Pow(float64(base.Data), float64(exp.Data))))
+	}
+	return Value(powInt(base.Data, exp.Data))
+}
+
+// AddChecked Get a + b, or NULL if any of them is NULL. Returns ErrOverflow if the result does not fit in T
+func AddChecked[T Number](a, b Nullable[T]) (Nullable[T], error) {
+	result := Add(a, b)
+	if !result.Valid {
+		return result, nil
+	}
+	if isFloat[T]() {
+		return checkFloat(result, a.Data, b.Data)
+	}
+	if (b.Data > 0 && result.Data < a.Data) || (b.Data < 0 && result.Data > a.Data) {
+		return Null[T](), ErrOverflow
+	}
+	return result, nil
+}
+
+// SubChecked Get a - b, or NULL if any of them is NULL. Returns ErrOverflow if the result does not fit in T
+func SubChecked[T Number](a, b Nullable[T]) (Nullable[T], error) {
+	result := Sub(a, b)
+	if !result.Valid {
+		return result, nil
+	}
+	if isFloat[T]() {
+		return checkFloat(result, a.Data, b.Data)
+	}
+	if (b.Data > 0 && result.Data > a.Data) || (b.Data < 0 && result.Data < a.Data) {
+		return Null[T](), ErrOverflow
+	}
+	return result, nil
+}
+
+// MulChecked Get a * b, or NULL if any of them is NULL. Returns ErrOverflow if the result does not fit in T
+func MulChecked[T Number](a, b Nullable[T]) (Nullable[T], error) {
+	result := Mul(a, b)
+	if !result.Valid {
+		return result, nil
+	}
+	if isFloat[T]() {
+		return checkFloat(result, a.Data, b.Data)
+	}
+	if a.Data == 0 || b.Data == 0 {
+		return result, nil
+	}
+	if (a.Data == T(0)-1 && isMinInt(b.Data)) || (b.Data == T(0)-1 && isMinInt(a.Data)) || result.Data/b.Data != a.Data {
+		return Null[T](), ErrOverflow
+	}
+	return result, nil
+}
+
+// Convert Convert a Nullable number to another numeric type, or NULL if n is NULL.
+// Returns ErrOverflow if the value does not fit in U. Fractions are truncated when converting to an integer type
+func Convert[U, T Number](n Nullable[T]) (Nullable[U], error) {
+	if !n.Valid {
+		return Null[U](), nil
+	}
+
+	value := n.Data
+	converted := U(value)
+	switch {
+	case isFloat[U]():
+		if math.IsInf(float64(converted), 0) && !math.IsInf(float64(value), 0) {
+			return Null[U](), ErrOverflow
+		}
+	case isFloat[T]():
+		if math.IsNaN(float64(value)) || math.Trunc(float64(value)) != float64(converted) {
+			return Null[U](), ErrOverflow
+		}
+	default:
+		if T(converted) != value || (value < 0) != (converted < 0) {
+			return Null[U](), ErrOverflow
+		}
+	}
+	return Value(converted), nil
+}
+
+// ConvertOrNull Convert a Nullable number to another numeric type, or NULL if n is NULL or the value does not fit in U
+func ConvertOrNull[U, T Number](n Nullable[T]) Nullable[U] {
+	converted, err := Convert[U](n)
+	if err != nil {
+		return Null[U]()
+	}
+	return converted
+}
+
+func isFloat[T Number]() bool {
+	one := T(1)
+	return one/(one+one) != 0
+}
+
+func isMinInt[T Number](value T) bool {
+	return value < 0 && -value == value
+}
+
+func checkFloat[T Number](result Nullable[T], a, b T) (Nullable[T], error) {
+	inputInf := math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0)
+	if math.IsInf(float64(result.Data), 0) && !inputInf {
+		return Null[T](), ErrOverflow
+	}
+	return result, nil
+}
+
+func powInt[T Number](base, exp T) T {
+	if exp < 0 {
+		switch {
+		case base == 1:
+			return 1
+		case base == T(0)-1 && powIntOdd(exp):
+			return base
+		case base == T(0)-1:
+			return 1
+		}
+		return 0
+	}
+
+	result := T(1)
+	for exp > 0 {
+		if powIntOdd(exp) {
+			result *= base
+		}
+		base *= base
+		exp /= 2
+	}
+	return result
+}
+
+func powIntOdd[T Number](value T) bool {
+	return value/2*2 != value
+}
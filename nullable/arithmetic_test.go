@@ -0,0 +1,124 @@
+package nullable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func Test_Arithmetic_null_propagation(t *testing.T) {
+	null := Null[int]()
+	five := Value(5)
+
+	assert.False(t, Add(five, null).Valid)
+	assert.False(t, Sub(null, five).Valid)
+	assert.False(t, Mul(null, null).Valid)
+	assert.False(t, Div(five, null).Valid)
+	assert.False(t, DivOrNull(null, five).Valid)
+	assert.False(t, Neg(null).Valid)
+	assert.False(t, Abs(null).Valid)
+	assert.False(t, Pow(five, null).Valid)
+}
+
+func Test_Arithmetic_int(t *testing.T) {
+	assert.Equal(t, Value(8), Add(Value(5), Value(3)))
+	assert.Equal(t, Value(2), Sub(Value(5), Value(3)))
+	assert.Equal(t, Value(15), Mul(Value(5), Value(3)))
+	assert.Equal(t, Value(1), Div(Value(5), Value(3)))
+	assert.Equal(t, Value(-5), Neg(Value(5)))
+	assert.Equal(t, Value(5), Abs(Value(-5)))
+	assert.Equal(t, Value(125), Pow(Value(5), Value(3)))
+	assert.Equal(t, Value(1), Pow(Value(5), Value(0)))
+	assert.Equal(t, Value(0), Pow(Value(5), Value(-1)))
+	assert.Equal(t, Value(-1), Pow(Value(-1), Value(-3)))
+}
+
+func Test_Arithmetic_float(t *testing.T) {
+	assert.Equal(t, Value(2.5), Div(Value(5.0), Value(2.0)))
+	assert.Equal(t, Value(1.5), Abs(Value(-1.5)))
+	assert.Equal(t, Value(0.25), Pow(Value(2.0), Value(-2.0)))
+	assert.Equal(t, Value(float32(8)), Pow(Value(float32(2)), Value(float32(3))))
+}
+
+func Test_Arithmetic_named_type(t *testing.T) {
+	type price float64
+	total := Mul(Value(price(2.5)), Value(price(4)))
+	assert.Equal(t, Value(price(10)), total)
+}
+
+func Test_Arithmetic_DivOrNull(t *testing.T) {
+	assert.False(t, DivOrNull(Value(5), Value(0)).Valid)
+	assert.False(t, DivOrNull(Value(5.0), Value(0.0)).Valid)
+	assert.Equal(t, Value(2), DivOrNull(Value(5), Value(2)))
+	assert.Panics(t, func() { Div(Value(5), Value(0)) })
+}
+
+func Test_Arithmetic_checked(t *testing.T) {
+	sum, err := AddChecked(Value(int8(100)), Value(int8(27)))
+	assert.NoError(t, err)
+	assert.Equal(t, Value(int8(127)), sum)
+
+	_, err = AddChecked(Value(int8(100)), Value(int8(28)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = AddChecked(Value(uint8(200)), Value(uint8(56)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = SubChecked(Value(int8(-100)), Value(int8(29)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = SubChecked(Value(uint(0)), Value(uint(1)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	product, err := MulChecked(Value(int16(-128)), Value(int16(256)))
+	assert.NoError(t, err)
+	assert.Equal(t, Value(int16(math.MinInt16)), product)
+
+	_, err = MulChecked(Value(int16(256)), Value(int16(128)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = MulChecked(Value(int64(-1)), Value(int64(math.MinInt64)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = MulChecked(Value(math.MaxFloat64), Value(2.0))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	null, err := MulChecked(Value(math.MaxInt), Null[int]())
+	assert.NoError(t, err)
+	assert.False(t, null.Valid)
+}
+
+func Test_Arithmetic_Convert(t *testing.T) {
+	small, err := Convert[int8](Value(100))
+	assert.NoError(t, err)
+	assert.Equal(t, Value(int8(100)), small)
+
+	_, err = Convert[int8](Value(200))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Convert[uint](Value(-1))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Convert[int64](Value(uint64(math.MaxUint64)))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	truncated, err := Convert[int](Value(2.9))
+	assert.NoError(t, err)
+	assert.Equal(t, Value(2), truncated)
+
+	_, err = Convert[int32](Value(1e10))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Convert[int](Value(math.NaN()))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Convert[float32](Value(1e300))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	null, err := Convert[int8](Null[int]())
+	assert.NoError(t, err)
+	assert.False(t, null.Valid)
+
+	assert.False(t, ConvertOrNull[uint8](Value(256)).Valid)
+	assert.Equal(t, Value(uint8(255)), ConvertOrNull[uint8](Value(255)))
+}
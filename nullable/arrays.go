@@ -0,0 +1,309 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+// Array is a slice of Nullable that is scanned from and written to PostgreSQL arrays in text format,
+// like {1,NULL,3}. Multidimensional arrays are supported by nesting, like Array[Array[int]]
+type Array[T any] []Nullable[T]
+
+// pgArray is implemented by the array types, so nested arrays are written without quotes
+type pgArray interface {
+	pgArray()
+}
+
+func (a Array[T]) pgArray() {}
+
+// Scan Implement sql.Scanner for PostgreSQL arrays
+func (a *Array[T]) Scan(src any) error {
+	if src == nil {
+		*a = nil
+		return nil
+	}
+
+	elements, err := parseArraySource(src)
+	if err != nil {
+		return err
+	}
+
+	result := make(Array[T], len(elements))
+	for i, element := range elements {
+		if err = result[i].Scan(element); err != nil {
+			return fmt.Errorf("null: could not scan array element %d: %w", i, err)
+		}
+	}
+	*a = result
+	return nil
+}
+
+// Value Implement driver.Valuer for PostgreSQL arrays
+func (a Array[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return formatArray(len(a), func(i int) (driver.Value, bool, error) {
+		value, err := a[i].Value()
+		return value, a[i].isArray(), err
+	})
+}
+
+// scanReflectArray Scan a PostgreSQL array into dest, a slice with elements implementing sql.Scanner
+func scanReflectArray(dest reflect.Value, src any) error {
+	elements, err := parseArraySource(src)
+	if err != nil {
+		return err
+	}
+
+	result := reflect.MakeSlice(dest.Type(), len(elements), len(elements))
+	for i, element := range elements {
+		scanner := result.Index(i).Addr().Interface().(sql.Scanner)
+		if err = scanner.Scan(element); err != nil {
+			return fmt.Errorf("null: could not scan array element %d: %w", i, err)
+		}
+	}
+	dest.Set(result)
+	return nil
+}
+
+// reflectArrayValue Format value, a slice with elements implementing driver.Valuer, as a PostgreSQL array
+func reflectArrayValue(value reflect.Value) (driver.Value, error) {
+	if value.IsNil() {
+		return nil, nil
+	}
+	return formatArray(value.Len(), func(i int) (driver.Value, bool, error) {
+		element := value.Index(i).Interface()
+		elementValue, err := element.(driver.Valuer).Value()
+		nested, ok := element.(interface{ isArray() bool })
+		return elementValue, ok && nested.isArray(), err
+	})
+}
+
+// isArrayType Check if t is a slice type that is scanned and written as a PostgreSQL array
+func isArrayType(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.Slice &&
+		reflect.PointerTo(t.Elem()).Implements(scannerType) &&
+		t.Elem().Implements(valuerType)
+}
+
+// isArray Check if the data is written as a PostgreSQL array
+func (n Nullable[T]) isArray() bool {
+	if _, ok := any(n.Data).(pgArray); ok {
+		return true
+	}
+	return isArrayType(typeOf[T]())
+}
+
+func parseArraySource(src any) ([]any, error) {
+	switch s := src.(type) {
+	case string:
+		return parseArray(s)
+	case []byte:
+		return parseArray(string(s))
+	}
+	return nil, fmt.Errorf("null: cannot scan %T into an array", src)
+}
+
+// parseArray Parse a one-dimensional PostgreSQL array in text format. Elements are returned as strings,
+// or nil for NULL. Nested arrays are returned unparsed, so they can be scanned by the element type
+func parseArray(src string) ([]any, error) {
+	if strings.HasPrefix(src, "[") {
+		// Skip the dimension decoration, like [1:3]={1,2,3}
+		index := strings.IndexByte(src, '=')
+		if index < 0 {
+			return nil, errors.New("null: invalid array dimensions: " + src)
+		}
+		src = src[index+1:]
+	}
+
+	src = strings.TrimSpace(src)
+	if len(src) < 2 || src[0] != '{' || src[len(src)-1] != '}' {
+		return nil, errors.New("null: invalid array: " + src)
+	}
+	body := src[1 : len(src)-1]
+	if strings.TrimSpace(body) == "" {
+		return []any{}, nil
+	}
+
+	var elements []any
+	for i := 0; ; {
+		for i < len(body) && isArraySpace(body[i]) {
+			i++
+		}
+
+		var element any
+		var err error
+		switch {
+		case i < len(body) && body[i] == '{':
+			element, i, err = parseArrayNested(body, i)
+		case i < len(body) && body[i] == '"':
+			element, i, err = parseArrayQuoted(body, i)
+		default:
+			element, i = parseArrayUnquoted(body, i)
+		}
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+
+		for i < len(body) && isArraySpace(body[i]) {
+			i++
+		}
+		if i == len(body) {
+			return elements, nil
+		}
+		if body[i] != ',' {
+			return nil, fmt.Errorf("null: invalid array, unexpected %q at position %d: %s", body[i], i, src)
+		}
+		i++
+	}
+}
+
+func parseArrayNested(body string, start int) (string, int, error) {
+	depth := 0
+	quoted := false
+	for i := start; i < len(body); i++ {
+		switch {
+		case body[i] == '\\':
+			i++
+		case body[i] == '"':
+			quoted = !quoted
+		case quoted:
+		case body[i] == '{':
+			depth++
+		case body[i] == '}':
+			depth--
+			if depth == 0 {
+				return body[start : i+1], i + 1, nil
+			}
+		}
+	}
+	return "", 0, errors.New("null: invalid array, unterminated nested array")
+}
+
+func parseArrayQuoted(body string, start int) (string, int, error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+			if i < len(body) {
+				sb.WriteByte(body[i])
+			}
+		case '"':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(body[i])
+		}
+	}
+	return "", 0, errors.New("null: invalid array, unterminated quoted element")
+}
+
+func parseArrayUnquoted(body string, start int) (any, int) {
+	var sb strings.Builder
+	escaped := false
+	i := start
+	for ; i < len(body) && body[i] != ',' && body[i] != '}'; i++ {
+		if body[i] == '\\' && i+1 < len(body) {
+			i++
+			escaped = true
+		}
+		sb.WriteByte(body[i])
+	}
+
+	element := strings.TrimRightFunc(sb.String(), func(r rune) bool { return r < 128 && isArraySpace(byte(r)) })
+	if !escaped && strings.EqualFold(element, "NULL") {
+		return nil, i
+	}
+	return element, i
+}
+
+func isArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// formatArray Format a PostgreSQL array in text format from the values of its elements
+func formatArray(length int, element func(i int) (value driver.Value, nested bool, err error)) (driver.Value, error) {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i < length; i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		value, nested, err := element(i)
+		if err != nil {
+			return nil, fmt.Errorf("null: could not get value of array element %d: %w", i, err)
+		}
+		if nested && value != nil {
+			if str, ok := value.(string); ok {
+				sb.WriteString(str)
+				continue
+			}
+		}
+		writeArrayElement(&sb, value)
+	}
+	sb.WriteByte('}')
+	return sb.String(), nil
+}
+
+func writeArrayElement(sb *strings.Builder, value driver.Value) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		sb.WriteString("NULL")
+		return
+	case string:
+		str = v
+	case []byte:
+		str = `\x` + hex.EncodeToString(v)
+	case time.Time:
+		str = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+	case bool:
+		str = strconv.FormatBool(v)
+	case float64:
+		str = strconv.FormatFloat(v, 'g', -1, 64)
+	case float32:
+		str = strconv.FormatFloat(float64(v), 'g', -1, 32)
+	default:
+		str = fmt.Sprint(v)
+	}
+
+	if !arrayNeedsQuotes(str) {
+		sb.WriteString(str)
+		return
+	}
+	sb.WriteByte('"')
+	for i := 0; i < len(str); i++ {
+		if str[i] == '"' || str[i] == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(str[i])
+	}
+	sb.WriteByte('"')
+}
+
+func arrayNeedsQuotes(str string) bool {
+	if str == "" || strings.EqualFold(str, "NULL") {
+		return true
+	}
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '{', '}', ',', '"', '\\':
+			return true
+		}
+		if isArraySpace(str[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package nullable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Array_scan_int(t *testing.T) {
+	var a Array[int]
+	err := a.Scan("{1,NULL,3}")
+	assert.NoError(t, err)
+	assert.Equal(t, Array[int]{Value(1), Null[int](), Value(3)}, a)
+
+	err = a.Scan([]byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, Array[int]{}, a)
+
+	err = a.Scan("[0:1]={4,5}")
+	assert.NoError(t, err)
+	assert.Equal(t, Array[int]{Value(4), Value(5)}, a)
+
+	err = a.Scan(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, a)
+
+	assert.Error(t, a.Scan("{1,a}"))
+	assert.Error(t, a.Scan("1,2"))
+	assert.Error(t, a.Scan(int64(1)))
+}
+
+func Test_Array_scan_string(t *testing.T) {
+	var a Array[string]
+	err := a.Scan(`{plain,"with space","quote\"and\\backslash","",NULL,"NULL", spaced ,"{braces}"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, Array[string]{
+		Value("plain"),
+		Value("with space"),
+		Value(`quote"and\backslash`),
+		Value(""),
+		Null[string](),
+		Value("NULL"),
+		Value("spaced"),
+		Value("{braces}"),
+	}, a)
+}
+
+func Test_Array_scan_bool_float(t *testing.T) {
+	var b Array[bool]
+	assert.NoError(t, b.Scan("{t,f,NULL}"))
+	assert.Equal(t, Array[bool]{Value(true), Value(false), Null[bool]()}, b)
+
+	var f Array[float64]
+	assert.NoError(t, f.Scan("{1.5,-2e3}"))
+	assert.Equal(t, Array[float64]{Value(1.5), Value(-2000.0)}, f)
+}
+
+func Test_Array_scan_nested(t *testing.T) {
+	var a Array[Array[int]]
+	err := a.Scan("{{1,2},NULL,{NULL,4}}")
+	assert.NoError(t, err)
+	assert.Equal(t, Array[Array[int]]{
+		Value(Array[int]{Value(1), Value(2)}),
+		Null[Array[int]](),
+		Value(Array[int]{Null[int](), Value(4)}),
+	}, a)
+
+	var s Array[Array[string]]
+	err = s.Scan(`{{"a}",b},{"c,d",NULL}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, Array[Array[string]]{
+		Value(Array[string]{Value("a}"), Value("b")}),
+		Value(Array[string]{Value("c,d"), Null[string]()}),
+	}, s)
+}
+
+func Test_Array_value(t *testing.T) {
+	value, err := Array[int]{Value(1), Null[int](), Value(3)}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{1,NULL,3}", value)
+
+	value, err = Array[string]{Value("plain"), Value("with space"), Value(`a"b\c`), Value(""), Value("null"), Null[string]()}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{plain,"with space","a\"b\\c","","null",NULL}`, value)
+
+	value, err = Array[Array[int]]{Value(Array[int]{Value(1), Value(2)}), Null[Array[int]]()}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{{1,2},NULL}", value)
+
+	value, err = Array[bool]{Value(true), Value(false)}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{true,false}", value)
+
+	value, err = Array[int](nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test_Array_round_trip(t *testing.T) {
+	source := Array[string]{Value(`{"json": [1, 2]}`), Value("\ttab"), Value(`\`), Null[string]()}
+	value, err := source.Value()
+	assert.NoError(t, err)
+
+	var result Array[string]
+	assert.NoError(t, result.Scan(value))
+	assert.Equal(t, source, result)
+}
+
+func Test_Nullable_slice_of_nullable(t *testing.T) {
+	var n Nullable[[]Nullable[string]]
+	err := n.Scan(`{a,NULL,"b c"}`)
+	assert.NoError(t, err)
+	assert.True(t, n.Valid)
+	assert.Equal(t, []Nullable[string]{Value("a"), Null[string](), Value("b c")}, n.Data)
+
+	value, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{a,NULL,"b c"}`, value)
+
+	err = n.Scan(nil)
+	assert.NoError(t, err)
+	assert.False(t, n.Valid)
+
+	var deep Nullable[[]Nullable[[]Nullable[int]]]
+	assert.NoError(t, deep.Scan("{{1,NULL},{3}}"))
+	assert.Equal(t, []Nullable[[]Nullable[int]]{
+		Value([]Nullable[int]{Value(1), Null[int]()}),
+		Value([]Nullable[int]{Value(3)}),
+	}, deep.Data)
+	value, err = deep.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{{1,NULL},{3}}", value)
+}
+
+func Test_Nullable_array(t *testing.T) {
+	var n Nullable[Array[int]]
+	assert.NoError(t, n.Scan("{1,2}"))
+	assert.True(t, n.Valid)
+	assert.Equal(t, Array[int]{Value(1), Value(2)}, n.Data)
+
+	value, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{1,2}", value)
+}
+
+func Test_Nullable_value_nil_interface(t *testing.T) {
+	n := Value[any](nil)
+	value, err := n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	var stringer Nullable[interface{ String() string }]
+	stringer.Valid = true
+	value, err = stringer.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
@@ -0,0 +1,219 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const benchmarkRows = 1_000_000
+
+type benchmarkRow struct {
+	Id      int64
+	Name    Nullable[string]
+	Amount  Nullable[float64]
+	Count   Nullable[int]
+	Active  Nullable[bool]
+	Created Nullable[time.Time]
+}
+
+// benchmarkValues are boxed once, so the test driver does not allocate for each row
+var benchmarkValues = []driver.Value{
+	int64(123456), "name", 1234.5, int64(42), true, time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+}
+
+func benchmarkScan[T any](b *testing.B, src any) {
+	b.ReportAllocs()
+	var n Nullable[T]
+	for i := 0; i < b.N; i++ {
+		if err := n.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Scan(b *testing.B) {
+	b.Run("int64", func(b *testing.B) { benchmarkScan[int64](b, benchmarkValues[0]) })
+	b.Run("int", func(b *testing.B) { benchmarkScan[int](b, benchmarkValues[0]) })
+	b.Run("string", func(b *testing.B) { benchmarkScan[string](b, benchmarkValues[1]) })
+	b.Run("float64", func(b *testing.B) { benchmarkScan[float64](b, benchmarkValues[2]) })
+	b.Run("bool", func(b *testing.B) { benchmarkScan[bool](b, benchmarkValues[4]) })
+	b.Run("time", func(b *testing.B) { benchmarkScan[time.Time](b, benchmarkValues[5]) })
+	b.Run("named", func(b *testing.B) { benchmarkScan[color](b, benchmarkValues[1]) })
+	b.Run("null", func(b *testing.B) { benchmarkScan[int64](b, nil) })
+}
+
+func Benchmark_Scan_rows(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rows := queryGeneratedRows(b, []string{"id", "name", "amount", "count", "active", "created"}, benchmarkRows,
+			func(i int, dest []driver.Value) {
+				copy(dest, benchmarkValues)
+				if i%2 == 0 {
+					dest[1], dest[3] = nil, nil
+				}
+			})
+
+		var row benchmarkRow
+		for rows.Next() {
+			if err := rows.Scan(&row.Id, &row.Name, &row.Amount, &row.Count, &row.Active, &row.Created); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if err := rows.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_ScanAll_rows(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rows := queryGeneratedRows(b, []string{"id", "name", "amount", "count", "active", "created"}, benchmarkRows,
+			func(i int, dest []driver.Value) { copy(dest, benchmarkValues) })
+		result, err := ScanAll[benchmarkRow](rows)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(result) != benchmarkRows {
+			b.Fatalf("scanned %d rows", len(result))
+		}
+	}
+}
+
+func benchmarkJSONRows() []benchmarkRow {
+	rows := make([]benchmarkRow, 10_000)
+	for i := range rows {
+		rows[i] = benchmarkRow{
+			Id:      int64(i),
+			Name:    Value("name"),
+			Amount:  Value(float64(i) / 4),
+			Count:   Null[int](),
+			Active:  Value(i%3 == 0),
+			Created: Value(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+		}
+	}
+	return rows
+}
+
+func Benchmark_MarshalJSON_array(b *testing.B) {
+	rows := benchmarkJSONRows()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_UnmarshalJSON_array(b *testing.B) {
+	data, err := json.Marshal(benchmarkJSONRows())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var rows []benchmarkRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_UnmarshalJSON(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		b.ReportAllocs()
+		var n Nullable[int]
+		data := []byte("123456")
+		for i := 0; i < b.N; i++ {
+			if err := n.UnmarshalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("bool", func(b *testing.B) {
+		b.ReportAllocs()
+		var n Nullable[bool]
+		data := []byte("true")
+		for i := 0; i < b.N; i++ {
+			if err := n.UnmarshalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Benchmark_UnmarshalText(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		b.ReportAllocs()
+		var n Nullable[int]
+		text := []byte("123456")
+		for i := 0; i < b.N; i++ {
+			if err := n.UnmarshalText(text); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("float64", func(b *testing.B) {
+		b.ReportAllocs()
+		var n Nullable[float64]
+		text := []byte("1234.5")
+		for i := 0; i < b.N; i++ {
+			if err := n.UnmarshalText(text); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func benchmarkAppend(b *testing.B, appendTo func([]byte) ([]byte, error)) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 128)
+	for i := 0; i < b.N; i++ {
+		var err error
+		if buf, err = appendTo(buf[:0]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_AppendText(b *testing.B) {
+	b.Run("int", func(b *testing.B) { benchmarkAppend(b, Value(123456).AppendText) })
+	b.Run("float64", func(b *testing.B) { benchmarkAppend(b, Value(1234.5).AppendText) })
+	b.Run("bool", func(b *testing.B) { benchmarkAppend(b, Value(true).AppendText) })
+	b.Run("string", func(b *testing.B) { benchmarkAppend(b, Value("hello").AppendText) })
+	b.Run("time", func(b *testing.B) { benchmarkAppend(b, Value(benchmarkValues[5].(time.Time)).AppendText) })
+}
+
+func Benchmark_AppendJSON(b *testing.B) {
+	b.Run("int", func(b *testing.B) { benchmarkAppend(b, Value(123456).AppendJSON) })
+	b.Run("float64", func(b *testing.B) { benchmarkAppend(b, Value(1234.5).AppendJSON) })
+	b.Run("bool", func(b *testing.B) { benchmarkAppend(b, Value(true).AppendJSON) })
+	b.Run("string", func(b *testing.B) { benchmarkAppend(b, Value("hello <world>").AppendJSON) })
+	b.Run("time", func(b *testing.B) { benchmarkAppend(b, Value(benchmarkValues[5].(time.Time)).AppendJSON) })
+	b.Run("null", func(b *testing.B) { benchmarkAppend(b, Null[int]().AppendJSON) })
+}
+
+func Benchmark_MarshalJSON(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		b.ReportAllocs()
+		n := Value(123456)
+		for i := 0; i < b.N; i++ {
+			if _, err := n.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("struct", func(b *testing.B) {
+		b.ReportAllocs()
+		n := Value(address{AddressLine1: "Street 1"})
+		for i := 0; i < b.N; i++ {
+			if _, err := n.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package nullable
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"sync"
+)
+
+// BytesEncoding is a text encoding of byte slices
+type BytesEncoding int
+
+const (
+	// BytesBase64 is standard base64 with padding, like encoding/json uses for []byte
+	BytesBase64 BytesEncoding = iota
+	// BytesBase64URL is URL-safe base64 without padding
+	BytesBase64URL
+	// BytesHex is lower case hexadecimal
+	BytesHex
+)
+
+var bytesEncodings sync.Map
+
+// RegisterBytesEncoding Use encoding for MarshalText and UnmarshalText of Nullable[T], instead of BytesBase64.
+// The returned function restores the encoding that was registered before, which is useful in tests
+func RegisterBytesEncoding[T ~[]byte](encoding BytesEncoding) (restore func()) {
+	return registerCodec[T](&bytesEncodings, &encoding)
+}
+
+func (e BytesEncoding) encode(b []byte) []byte {
+	switch e {
+	case BytesBase64URL:
+		return base64.RawURLEncoding.AppendEncode(nil, b)
+	case BytesHex:
+		return hex.AppendEncode(nil, b)
+	}
+	return base64.StdEncoding.AppendEncode(nil, b)
+}
+
+func (e BytesEncoding) decode(text string) ([]byte, error) {
+	switch e {
+	case BytesBase64URL:
+		return base64.RawURLEncoding.DecodeString(text)
+	case BytesHex:
+		return hex.DecodeString(text)
+	}
+	return base64.StdEncoding.DecodeString(text)
+}
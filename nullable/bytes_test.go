@@ -0,0 +1,162 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Bytes_Scan(t *testing.T) {
+	src := []byte("blob")
+	var b Nullable[[]byte]
+	assert.NoError(t, b.Scan(src))
+	assert.Equal(t, Value([]byte("blob")), b)
+
+	// The driver may reuse its buffer after Scan
+	src[0] = 'g'
+	assert.Equal(t, []byte("blob"), b.Data)
+
+	assert.NoError(t, b.Scan([]byte{}))
+	assert.True(t, b.Valid)
+	assert.NotNil(t, b.Data)
+	assert.Empty(t, b.Data)
+
+	assert.NoError(t, b.Scan("text"))
+	assert.Equal(t, Value([]byte("text")), b)
+
+	assert.NoError(t, b.Scan(nil))
+	assert.False(t, b.Valid)
+
+	assert.Error(t, b.Scan(struct{}{}))
+	assert.False(t, b.Valid)
+}
+
+func Test_Bytes_Value(t *testing.T) {
+	value, err := Value([]byte("blob")).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("blob"), value)
+
+	value, err = Value([]byte(nil)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{}, value)
+
+	value, err = Null[[]byte]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = Value(json.RawMessage(`{"a":1}`)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"a":1}`), value)
+}
+
+func Test_RawMessage_Scan(t *testing.T) {
+	src := []byte(`{"a":1}`)
+	var m Nullable[json.RawMessage]
+	assert.NoError(t, m.Scan(src))
+	src[2] = 'b'
+	assert.Equal(t, Value(json.RawMessage(`{"a":1}`)), m)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+
+	assert.NoError(t, m.Scan(nil))
+	assert.False(t, m.Valid)
+}
+
+func Test_Bytes_text(t *testing.T) {
+	tests := map[BytesEncoding]string{
+		BytesBase64:    "+/8AYQ==",
+		BytesBase64URL: "-_8AYQ",
+		BytesHex:       "fbff0061",
+	}
+	blob := []byte{0xfb, 0xff, 0x00, 'a'}
+	for encoding, expected := range tests {
+		restore := RegisterBytesEncoding[[]byte](encoding)
+
+		text, err := Value(blob).MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, string(text))
+
+		var b Nullable[[]byte]
+		assert.NoError(t, b.UnmarshalText(text))
+		assert.Equal(t, Value(blob), b)
+
+		assert.Error(t, b.UnmarshalText([]byte("!!")))
+		assert.False(t, b.Valid)
+		restore()
+	}
+
+	var b Nullable[[]byte]
+	assert.NoError(t, b.UnmarshalText([]byte("")))
+	assert.False(t, b.Valid)
+}
+
+// hexBytes is a byte slice written as hexadecimal text
+type hexBytes []byte
+
+func Test_Bytes_text_per_type(t *testing.T) {
+	restore := RegisterBytesEncoding[hexBytes](BytesHex)
+	defer restore()
+
+	text, err := Value(hexBytes{0xfb, 0xff}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "fbff", string(text))
+
+	var h Nullable[hexBytes]
+	assert.NoError(t, h.UnmarshalText([]byte("fbff")))
+	assert.Equal(t, Value(hexBytes{0xfb, 0xff}), h)
+
+	// Other byte slices keep base64
+	text, err = Value([]byte{0xfb, 0xff}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "+/8=", string(text))
+}
+
+func Test_RawMessage_text(t *testing.T) {
+	text, err := Value(json.RawMessage(`[1,2]`)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, string(text))
+
+	var m Nullable[json.RawMessage]
+	assert.NoError(t, m.UnmarshalText([]byte(`{"b":true}`)))
+	assert.Equal(t, Value(json.RawMessage(`{"b":true}`)), m)
+}
+
+func Test_Bytes_ScanStruct(t *testing.T) {
+	type row struct {
+		Blob     []byte
+		Optional Nullable[[]byte]
+	}
+
+	rows := queryTestRows(t, []string{"blob", "optional"}, []driver.Value{[]byte{}, nil})
+	result, err := ScanAll[row](rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []row{{Blob: []byte{}}}, result)
+}
+
+func Test_Bytes_Equal_and_IsZero(t *testing.T) {
+	assert.True(t, Value([]byte("a")).Equal(Value([]byte("a"))))
+	assert.False(t, Value([]byte("a")).ExactEqual(Value([]byte("b"))))
+	assert.False(t, Value([]byte("a")).Equal(Null[[]byte]()))
+	assert.True(t, Value(json.RawMessage(`{}`)).Equal(Value(json.RawMessage(`{}`))))
+	assert.True(t, Value(map[string]int{"a": 1}).Equal(Value(map[string]int{"a": 1})))
+	assert.False(t, Value([]string{"a"}).Equal(Value([]string{"b"})))
+	assert.True(t, Value[any]([]int{1}).Equal(Value[any]([]int{1})))
+
+	assert.True(t, Value[[]byte](nil).IsZero())
+	assert.False(t, Value([]byte("a")).IsZero())
+	assert.True(t, Value[map[string]int](nil).IsZero())
+	assert.False(t, Value[any]([]int{1}).IsZero())
+
+	type row struct {
+		Blob Nullable[[]byte] `json:"blob,omitzero"`
+	}
+	data, err := json.Marshal(row{Blob: Null[[]byte]()})
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+	data, err = json.Marshal(row{Blob: Value([]byte{1})})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"blob":"AQ=="}`, string(data))
+}
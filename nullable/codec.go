@@ -0,0 +1,88 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"sync"
+)
+
+// textCodec, sqlCodec and jsonCodec hold the functions registered for a type. They are kept in the registries
+// by the reflect.Type of T, so a registry entry can always be asserted to the codec of the looked up type
+type textCodec[T any] struct {
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
+}
+
+type sqlCodec[T any] struct {
+	encode func(T) (driver.Value, error)
+	decode func(src any) (T, error)
+}
+
+type jsonCodec[T any] struct {
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
+}
+
+var textCodecs, sqlCodecs, jsonCodecs sync.Map
+
+// RegisterText Use encode and decode in MarshalText and UnmarshalText of Nullable[T], before any other way of
+// writing and reading T. NULL is handled by Nullable, so the functions only get valid values and non-blank text.
+// The returned function restores the codec that was registered before, which is useful in tests
+func RegisterText[T any](encode func(T) ([]byte, error), decode func([]byte) (T, error)) (restore func()) {
+	return registerCodec[T](&textCodecs, &textCodec[T]{encode: encode, decode: decode})
+}
+
+// RegisterSQL Use encode and decode in Value and Scan of Nullable[T], before any other way of writing and reading T.
+// NULL is handled by Nullable, so the functions only get valid values and non-nil sources.
+// The returned function restores the codec that was registered before, which is useful in tests
+func RegisterSQL[T any](encode func(T) (driver.Value, error), decode func(src any) (T, error)) (restore func()) {
+	return registerCodec[T](&sqlCodecs, &sqlCodec[T]{encode: encode, decode: decode})
+}
+
+// RegisterJSON Use encode and decode in MarshalJSON and UnmarshalJSON of Nullable[T], before any other way of
+// writing and reading T. NULL is handled by Nullable, so the functions only get valid values and JSON other than null.
+// The returned function restores the codec that was registered before, which is useful in tests
+func RegisterJSON[T any](encode func(T) ([]byte, error), decode func([]byte) (T, error)) (restore func()) {
+	return registerCodec[T](&jsonCodecs, &jsonCodec[T]{encode: encode, decode: decode})
+}
+
+func registerCodec[T any](registry *sync.Map, codec any) func() {
+	key := typeOf[T]()
+	previous, loaded := registry.Swap(key, codec)
+	invalidateTypePlans()
+	return func() {
+		if loaded {
+			registry.Store(key, previous)
+		} else {
+			registry.Delete(key)
+		}
+		invalidateTypePlans()
+	}
+}
+
+func lookupTextCodec[T any]() *textCodec[T] {
+	return planOf[T]().textCodec
+}
+
+func lookupSQLCodec[T any]() *sqlCodec[T] {
+	return planOf[T]().sqlCodec
+}
+
+func lookupJSONCodec[T any]() *jsonCodec[T] {
+	return planOf[T]().jsonCodec
+}
+
+// scanAny Decode src for a field of type T, without knowing T, like in ScanStruct
+func (c *sqlCodec[T]) scanAny(src any) (any, error) {
+	return c.decode(src)
+}
+
+// decodeWith Set the data from decode, or set the Nullable to NULL if it fails
+func (n *Nullable[T]) decodeWith(decode func() (T, error)) error {
+	data, err := decode()
+	if err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Data, n.Valid = data, true
+	return nil
+}
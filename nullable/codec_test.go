@@ -0,0 +1,194 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// vendorPoint stands for a type from another package, without any of the marshalling interfaces
+type vendorPoint struct {
+	x, y int
+}
+
+func encodePoint(p vendorPoint) ([]byte, error) {
+	return []byte(fmt.Sprintf("%d;%d", p.x, p.y)), nil
+}
+
+func decodePoint(text []byte) (vendorPoint, error) {
+	var p vendorPoint
+	if _, err := fmt.Sscanf(string(text), "%d;%d", &p.x, &p.y); err != nil {
+		return vendorPoint{}, errors.New("bad point")
+	}
+	return p, nil
+}
+
+func Test_Codec_text(t *testing.T) {
+	defer RegisterText(encodePoint, decodePoint)()
+
+	text, err := Value(vendorPoint{1, 2}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1;2", string(text))
+
+	var p Nullable[vendorPoint]
+	assert.NoError(t, p.UnmarshalText([]byte("3;4")))
+	assert.Equal(t, Value(vendorPoint{3, 4}), p)
+
+	assert.EqualError(t, p.UnmarshalText([]byte("x")), "bad point")
+	assert.False(t, p.Valid)
+
+	assert.NoError(t, p.UnmarshalText([]byte("")))
+	assert.False(t, p.Valid)
+	text, err = Null[vendorPoint]().MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func Test_Codec_JSON(t *testing.T) {
+	defer RegisterJSON(
+		func(p vendorPoint) ([]byte, error) { return json.Marshal([]int{p.x, p.y}) },
+		func(data []byte) (vendorPoint, error) {
+			var xy [2]int
+			err := json.Unmarshal(data, &xy)
+			return vendorPoint{xy[0], xy[1]}, err
+		},
+	)()
+
+	data, err := json.Marshal(map[string]Nullable[vendorPoint]{"a": Value(vendorPoint{5, 6}), "b": Null[vendorPoint]()})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":[5,6],"b":null}`, string(data))
+
+	var decoded map[string]Nullable[vendorPoint]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, map[string]Nullable[vendorPoint]{"a": Value(vendorPoint{5, 6}), "b": Null[vendorPoint]()}, decoded)
+
+	var p Nullable[vendorPoint]
+	assert.Error(t, json.Unmarshal([]byte(`"5;6"`), &p))
+	assert.False(t, p.Valid)
+}
+
+func Test_Codec_SQL(t *testing.T) {
+	defer RegisterSQL(
+		func(p vendorPoint) (driver.Value, error) { return fmt.Sprintf("(%d,%d)", p.x, p.y), nil },
+		func(src any) (vendorPoint, error) {
+			var p vendorPoint
+			_, err := fmt.Sscanf(fmt.Sprintf("%s", src), "(%d,%d)", &p.x, &p.y)
+			return p, err
+		},
+	)()
+
+	value, err := Value(vendorPoint{7, 8}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "(7,8)", value)
+
+	var p Nullable[vendorPoint]
+	assert.NoError(t, p.Scan([]byte("(9,10)")))
+	assert.Equal(t, Value(vendorPoint{9, 10}), p)
+	assert.NoError(t, p.Scan(nil))
+	assert.False(t, p.Valid)
+	assert.Error(t, p.Scan("nope"))
+
+	type row struct {
+		Position vendorPoint
+		Previous Nullable[vendorPoint]
+	}
+	rows := queryTestRows(t, []string{"position", "previous"}, []driver.Value{"(1,1)", nil})
+	result, err := ScanAll[row](rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []row{{Position: vendorPoint{1, 1}}}, result)
+}
+
+func Test_Codec_overridesBuiltIn(t *testing.T) {
+	restore := RegisterText(
+		func(b bool) ([]byte, error) { return map[bool][]byte{true: []byte("on"), false: []byte("off")}[b], nil },
+		func(text []byte) (bool, error) { return string(text) == "on", nil },
+	)
+
+	text, err := Value(true).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "on", string(text))
+
+	inner := RegisterText(
+		func(b bool) ([]byte, error) { return []byte("yes"), nil },
+		func(text []byte) (bool, error) { return true, nil },
+	)
+	text, _ = Value(true).MarshalText()
+	assert.Equal(t, "yes", string(text))
+
+	inner()
+	text, _ = Value(true).MarshalText()
+	assert.Equal(t, "on", string(text))
+
+	restore()
+	text, _ = Value(true).MarshalText()
+	assert.Equal(t, "true", string(text))
+}
+
+func Test_Codec_beforeEnum(t *testing.T) {
+	defer registerTestEnums()()
+	decode := func(text []byte) (priority, error) {
+		var p priority
+		_, err := fmt.Sscanf(string(text), "p%d", &p)
+		return p, err
+	}
+	defer RegisterText(func(p priority) ([]byte, error) { return []byte(fmt.Sprintf("p%d", p)), nil }, decode)()
+	defer RegisterJSON(
+		func(p priority) ([]byte, error) { return json.Marshal(fmt.Sprintf("p%d", p)) },
+		func(data []byte) (priority, error) {
+			var text string
+			if err := json.Unmarshal(data, &text); err != nil {
+				return 0, err
+			}
+			return decode([]byte(text))
+		},
+	)()
+
+	var p Nullable[priority]
+	assert.NoError(t, p.UnmarshalText([]byte("p2")))
+	assert.Equal(t, Value(priorityHigh), p)
+	assert.Error(t, p.UnmarshalText([]byte("low")))
+
+	assert.NoError(t, json.Unmarshal([]byte(`"p1"`), &p))
+	assert.Equal(t, Value(priorityLow), p)
+	assert.Error(t, json.Unmarshal([]byte(`"high"`), &p))
+}
+
+func Test_Codec_SQL_nil_interface(t *testing.T) {
+	defer RegisterSQL(
+		func(s fmt.Stringer) (driver.Value, error) { return s.String(), nil },
+		func(src any) (fmt.Stringer, error) { return nil, nil },
+	)()
+
+	type row struct {
+		Label fmt.Stringer
+	}
+	rows := queryTestRows(t, []string{"label"}, []driver.Value{"a"})
+	result, err := ScanAll[row](rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []row{{}}, result)
+}
+
+func Test_Codec_concurrent(t *testing.T) {
+	defer func() {
+		textCodecs.Delete(typeOf[vendorPoint]())
+		invalidateTypePlans()
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterText(encodePoint, decodePoint)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = Value(vendorPoint{1, 2}).MarshalText()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,186 @@
+package nullable
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Eq Check a = b with SQL semantics, returning NULL if any of them is NULL
+func Eq[T comparable](a, b Nullable[T]) Nullable[bool] {
+	if !a.Valid || !b.Valid {
+		return Null[bool]()
+	}
+	return Value(a.Equal(b))
+}
+
+// Ne Check a <> b with SQL semantics, returning NULL if any of them is NULL
+func Ne[T comparable](a, b Nullable[T]) Nullable[bool] {
+	return Not(Eq(a, b))
+}
+
+// Lt Check a < b with SQL semantics, returning NULL if any of them is NULL
+func Lt[T cmp.Ordered](a, b Nullable[T]) Nullable[bool] {
+	return compare(a, b, func(c int) bool { return c < 0 })
+}
+
+// Le Check a <= b with SQL semantics, returning NULL if any of them is NULL
+func Le[T cmp.Ordered](a, b Nullable[T]) Nullable[bool] {
+	return compare(a, b, func(c int) bool { return c <= 0 })
+}
+
+// Gt Check a > b with SQL semantics, returning NULL if any of them is NULL
+func Gt[T cmp.Ordered](a, b Nullable[T]) Nullable[bool] {
+	return compare(a, b, func(c int) bool { return c > 0 })
+}
+
+// Ge Check a >= b with SQL semantics, returning NULL if any of them is NULL
+func Ge[T cmp.Ordered](a, b Nullable[T]) Nullable[bool] {
+	return compare(a, b, func(c int) bool { return c >= 0 })
+}
+
+// Between Check value BETWEEN low AND high with SQL semantics
+func Between[T cmp.Ordered](value, low, high Nullable[T]) Nullable[bool] {
+	return And(Ge(value, low), Le(value, high))
+}
+
+// LtFunc Check a < b like Lt, for types ordered by compare, like time.Time.Compare or Decimal.Compare
+func LtFunc[T any](a, b Nullable[T], compare func(a, b T) int) Nullable[bool] {
+	return compareFunc(a, b, compare, func(c int) bool { return c < 0 })
+}
+
+// LeFunc Check a <= b like Le, for types ordered by compare
+func LeFunc[T any](a, b Nullable[T], compare func(a, b T) int) Nullable[bool] {
+	return compareFunc(a, b, compare, func(c int) bool { return c <= 0 })
+}
+
+// GtFunc Check a > b like Gt, for types ordered by compare
+func GtFunc[T any](a, b Nullable[T], compare func(a, b T) int) Nullable[bool] {
+	return compareFunc(a, b, compare, func(c int) bool { return c > 0 })
+}
+
+// GeFunc Check a >= b like Ge, for types ordered by compare
+func GeFunc[T any](a, b Nullable[T], compare func(a, b T) int) Nullable[bool] {
+	return compareFunc(a, b, compare, func(c int) bool { return c >= 0 })
+}
+
+// BetweenFunc Check value BETWEEN low AND high like Between, for types ordered by compare
+func BetweenFunc[T any](value, low, high Nullable[T], compare func(a, b T) int) Nullable[bool] {
+	return And(GeFunc(value, low, compare), LeFunc(value, high, compare))
+}
+
+// In Check value IN (list...) with SQL semantics. The result is NULL if value is NULL,
+// or if no element matches and the list contains a NULL
+func In[T comparable](value Nullable[T], list ...Nullable[T]) Nullable[bool] {
+	result := Value(false)
+	for _, element := range list {
+		result = Or(result, Eq(value, element))
+		if result.Valid && result.Data {
+			return result
+		}
+	}
+	return result
+}
+
+// Like Check value LIKE pattern with SQL semantics, where % matches any sequence of characters,
+// _ matches a single character, and \ escapes the next character
+func Like(value, pattern Nullable[string]) Nullable[bool] {
+	if !value.Valid || !pattern.Valid {
+		return Null[bool]()
+	}
+	return Value(like([]rune(value.Data), []rune(pattern.Data)))
+}
+
+// ILike Check value ILIKE pattern, a case-insensitive Like
+func ILike(value, pattern Nullable[string]) Nullable[bool] {
+	if !value.Valid || !pattern.Valid {
+		return Null[bool]()
+	}
+	return Like(Value(strings.ToLower(value.Data)), Value(strings.ToLower(pattern.Data)))
+}
+
+// IsDistinctFrom Check a IS DISTINCT FROM b, where NULL is equal to NULL
+func IsDistinctFrom[T comparable](a, b Nullable[T]) bool {
+	return !a.Equal(b)
+}
+
+// IsNotDistinctFrom Check a IS NOT DISTINCT FROM b, where NULL is equal to NULL
+func IsNotDistinctFrom[T comparable](a, b Nullable[T]) bool {
+	return a.Equal(b)
+}
+
+// And Get a AND b using SQL three-valued logic
+func And(a, b Nullable[bool]) Nullable[bool] {
+	if (a.Valid && !a.Data) || (b.Valid && !b.Data) {
+		return Value(false)
+	}
+	if !a.Valid || !b.Valid {
+		return Null[bool]()
+	}
+	return Value(true)
+}
+
+// Or Get a OR b using SQL three-valued logic
+func Or(a, b Nullable[bool]) Nullable[bool] {
+	if (a.Valid && a.Data) || (b.Valid && b.Data) {
+		return Value(true)
+	}
+	if !a.Valid || !b.Valid {
+		return Null[bool]()
+	}
+	return Value(false)
+}
+
+// Not Get NOT a using SQL three-valued logic
+func Not(a Nullable[bool]) Nullable[bool] {
+	if !a.Valid {
+		return a
+	}
+	return Value(!a.Data)
+}
+
+func compare[T cmp.Ordered](a, b Nullable[T], check func(c int) bool) Nullable[bool] {
+	return compareFunc(a, b, cmp.Compare[T], check)
+}
+
+func compareFunc[T any](a, b Nullable[T], compare func(a, b T) int, check func(c int) bool) Nullable[bool] {
+	if !a.Valid || !b.Valid {
+		return Null[bool]()
+	}
+	return Value(check(compare(a.Data, b.Data)))
+}
+
+func like(value, pattern []rune) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '%':
+			for len(pattern) > 0 && pattern[0] == '%' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(value); i++ {
+				if like(value[i:], pattern) {
+					return true
+				}
+			}
+			return false
+		case '_':
+			if len(value) == 0 {
+				return false
+			}
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(value) == 0 || value[0] != pattern[0] {
+				return false
+			}
+		}
+		value = value[1:]
+		pattern = pattern[1:]
+	}
+	return len(value) == 0
+}
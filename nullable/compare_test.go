@@ -0,0 +1,108 @@
+package nullable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	sqlTrue    = Value(true)
+	sqlFalse   = Value(false)
+	sqlUnknown = Null[bool]()
+)
+
+func Test_Compare_null(t *testing.T) {
+	null := Null[int]()
+	five := Value(5)
+
+	assert.Equal(t, sqlUnknown, Eq(five, null))
+	assert.Equal(t, sqlUnknown, Eq(null, null))
+	assert.Equal(t, sqlUnknown, Ne(null, five))
+	assert.Equal(t, sqlUnknown, Lt(null, five))
+	assert.Equal(t, sqlUnknown, Le(five, null))
+	assert.Equal(t, sqlUnknown, Gt(null, null))
+	assert.Equal(t, sqlUnknown, Ge(null, five))
+}
+
+func Test_Compare_values(t *testing.T) {
+	assert.Equal(t, sqlTrue, Eq(Value(5), Value(5)))
+	assert.Equal(t, sqlFalse, Eq(Value(5), Value(6)))
+	assert.Equal(t, sqlTrue, Ne(Value(5), Value(6)))
+	assert.Equal(t, sqlTrue, Lt(Value(5), Value(6)))
+	assert.Equal(t, sqlFalse, Lt(Value(5), Value(5)))
+	assert.Equal(t, sqlTrue, Le(Value(5), Value(5)))
+	assert.Equal(t, sqlTrue, Gt(Value("b"), Value("a")))
+	assert.Equal(t, sqlFalse, Ge(Value(1.5), Value(2.5)))
+	assert.Equal(t, sqlTrue, Eq(Value(timeValue1), Value(timeValue2)))
+	assert.Equal(t, sqlFalse, Eq(Value(timeValue1), Value(timeValue1.Add(time.Second))))
+}
+
+func Test_Compare_Between(t *testing.T) {
+	assert.Equal(t, sqlTrue, Between(Value(5), Value(1), Value(10)))
+	assert.Equal(t, sqlFalse, Between(Value(15), Value(1), Value(10)))
+	assert.Equal(t, sqlFalse, Between(Value(15), Null[int](), Value(10)))
+	assert.Equal(t, sqlUnknown, Between(Value(5), Null[int](), Value(10)))
+	assert.Equal(t, sqlUnknown, Between(Null[int](), Value(1), Value(10)))
+}
+
+func Test_Compare_func(t *testing.T) {
+	later := Value(timeValue1.Add(time.Hour))
+	assert.Equal(t, sqlTrue, LtFunc(Value(timeValue1), later, time.Time.Compare))
+	assert.Equal(t, sqlFalse, GtFunc(Value(timeValue1), later, time.Time.Compare))
+	assert.Equal(t, sqlTrue, LeFunc(Value(timeValue1), Value(timeValue2), time.Time.Compare))
+	assert.Equal(t, sqlUnknown, GeFunc(Null[time.Time](), later, time.Time.Compare))
+
+	assert.Equal(t, sqlTrue, GeFunc(Value(NewDecimal(150, 2)), Value(NewDecimal(15, 1)), Decimal.Compare))
+	assert.Equal(t, sqlTrue, BetweenFunc(Value(Date{2024, 2, 29}), Value(Date{2024, 1, 1}), Value(Date{2024, 12, 31}), Date.Compare))
+	assert.Equal(t, sqlFalse, BetweenFunc(Value(TimeOfDay{Hour: 23}), Value(TimeOfDay{Hour: 8}), Value(TimeOfDay{Hour: 17}), TimeOfDay.Compare))
+	assert.Equal(t, sqlUnknown, BetweenFunc(Value(Date{2024, 2, 29}), Null[Date](), Value(Date{2024, 12, 31}), Date.Compare))
+}
+
+func Test_Compare_In(t *testing.T) {
+	assert.Equal(t, sqlTrue, In(Value(2), Value(1), Value(2)))
+	assert.Equal(t, sqlFalse, In(Value(3), Value(1), Value(2)))
+	assert.Equal(t, sqlTrue, In(Value(2), Null[int](), Value(2)))
+	assert.Equal(t, sqlUnknown, In(Value(3), Null[int](), Value(2)))
+	assert.Equal(t, sqlUnknown, In(Null[int](), Value(1)))
+	assert.Equal(t, sqlFalse, In(Value(1)))
+}
+
+func Test_Compare_Like(t *testing.T) {
+	assert.Equal(t, sqlTrue, Like(Value("hello world"), Value("hello%")))
+	assert.Equal(t, sqlTrue, Like(Value("hello world"), Value("%o w%")))
+	assert.Equal(t, sqlTrue, Like(Value("hello"), Value("h_llo")))
+	assert.Equal(t, sqlTrue, Like(Value(""), Value("%")))
+	assert.Equal(t, sqlFalse, Like(Value("hello"), Value("h_lo")))
+	assert.Equal(t, sqlFalse, Like(Value("Hello"), Value("hello")))
+	assert.Equal(t, sqlTrue, Like(Value("100%"), Value(`100\%`)))
+	assert.Equal(t, sqlFalse, Like(Value("1000"), Value(`100\%`)))
+	assert.Equal(t, sqlTrue, Like(Value("åäö"), Value("_ä_")))
+	assert.Equal(t, sqlUnknown, Like(Null[string](), Value("%")))
+	assert.Equal(t, sqlUnknown, Like(Value("hello"), Null[string]()))
+
+	assert.Equal(t, sqlTrue, ILike(Value("Hello"), Value("hE%")))
+	assert.Equal(t, sqlUnknown, ILike(Null[string](), Value("%")))
+}
+
+func Test_Compare_IsDistinctFrom(t *testing.T) {
+	assert.False(t, IsDistinctFrom(Null[int](), Null[int]()))
+	assert.True(t, IsDistinctFrom(Null[int](), Value(5)))
+	assert.True(t, IsDistinctFrom(Value(4), Value(5)))
+	assert.False(t, IsDistinctFrom(Value(5), Value(5)))
+
+	assert.True(t, IsNotDistinctFrom(Null[int](), Null[int]()))
+	assert.False(t, IsNotDistinctFrom(Value(5), Null[int]()))
+	assert.True(t, IsNotDistinctFrom(Value(timeValue1), Value(timeValue2)))
+}
+
+func Test_Compare_logic(t *testing.T) {
+	assert.Equal(t, sqlFalse, And(sqlUnknown, sqlFalse))
+	assert.Equal(t, sqlUnknown, And(sqlUnknown, sqlTrue))
+	assert.Equal(t, sqlTrue, And(sqlTrue, sqlTrue))
+	assert.Equal(t, sqlTrue, Or(sqlUnknown, sqlTrue))
+	assert.Equal(t, sqlUnknown, Or(sqlUnknown, sqlFalse))
+	assert.Equal(t, sqlFalse, Or(sqlFalse, sqlFalse))
+	assert.Equal(t, sqlUnknown, Not(sqlUnknown))
+	assert.Equal(t, sqlFalse, Not(sqlTrue))
+}
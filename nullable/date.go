@@ -0,0 +1,132 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without time of day or time zone, like the SQL DATE type.
+// It is written as 2006-01-02 in JSON, text and SQL
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf Get the date of t in its location
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Today Get the current date in the given location
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// ParseDate Parse a date in the format 2006-01-02
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("null: could not parse date: %w", err)
+	}
+	return DateOf(t), nil
+}
+
+// String Format the date as 2006-01-02
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid Check if the date exists in the calendar
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In Get the time at midnight of the date in the given location
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// At Get the time at the time of day on the date in the given location
+func (d Date) At(tod TimeOfDay, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, tod.Hour, tod.Minute, tod.Second, tod.Nanosecond, loc)
+}
+
+// AddDays Get the date n days after d
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// AddDate Get the date the given number of years, months and days after d, normalized like time.Time.AddDate
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
+// DaysSince Get the number of days from other to d
+func (d Date) DaysSince(other Date) int {
+	// Unix seconds do not overflow for dates centuries apart, unlike a time.Duration
+	return int((d.In(time.UTC).Unix() - other.In(time.UTC).Unix()) / (24 * 60 * 60))
+}
+
+// Compare Compare d to other, returning -1 if d is before other, 1 if it is after, and 0 if they are the same date
+func (d Date) Compare(other Date) int {
+	return d.In(time.UTC).Compare(other.In(time.UTC))
+}
+
+// Before Check if d is before other
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After Check if d is after other
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// IsZero Check if the date is the zero Date
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	date, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading a time.Time or a string starting with a date in the format 2006-01-02
+func (d *Date) Scan(src any) error {
+	var str string
+	switch s := src.(type) {
+	case time.Time:
+		*d = DateOf(s)
+		return nil
+	case string:
+		str = s
+	case []byte:
+		str = string(s)
+	default:
+		return fmt.Errorf("null: cannot scan %T into Date", src)
+	}
+
+	if len(str) > len(dateLayout) && (str[len(dateLayout)] == 'T' || str[len(dateLayout)] == ' ') {
+		str = str[:len(dateLayout)]
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+// Value Implement driver.Valuer, writing the date as 2006-01-02
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
@@ -0,0 +1,92 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Date_parse(t *testing.T) {
+	d, err := ParseDate("2012-12-21")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2012, time.December, 21}, d)
+	assert.Equal(t, "2012-12-21", d.String())
+
+	_, err = ParseDate("2012-02-30")
+	assert.Error(t, err)
+	_, err = ParseDate("2012-12-21T00:00:00Z")
+	assert.Error(t, err)
+}
+
+func Test_Date_arithmetic(t *testing.T) {
+	d := Date{2012, time.December, 21}
+	assert.Equal(t, Date{2013, time.January, 1}, d.AddDays(11))
+	assert.Equal(t, Date{2012, time.November, 30}, d.AddDays(-21))
+	assert.Equal(t, Date{2013, time.February, 21}, d.AddDate(0, 2, 0))
+	assert.Equal(t, 11, Date{2013, time.January, 1}.DaysSince(d))
+	assert.Equal(t, -11, d.DaysSince(Date{2013, time.January, 1}))
+	// More than the 292 years a time.Duration can hold
+	assert.Equal(t, 154863, Date{2024, time.January, 1}.DaysSince(Date{1600, time.January, 1}))
+	assert.Equal(t, -154863, Date{1600, time.January, 1}.DaysSince(Date{2024, time.January, 1}))
+	assert.True(t, d.Before(d.AddDays(1)))
+	assert.True(t, d.After(d.AddDays(-1)))
+	assert.Equal(t, 0, d.Compare(d))
+	assert.True(t, d.IsValid())
+	assert.False(t, Date{2012, time.February, 30}.IsValid())
+	assert.Equal(t, timeValue1, d.At(TimeOfDay{Hour: 21, Minute: 21, Second: 21}, time.UTC))
+	assert.Equal(t, d, DateOf(timeValue1))
+}
+
+func Test_Date_nullable_json(t *testing.T) {
+	d := Value(Date{2012, time.December, 21})
+	data, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2012-12-21"`, string(data))
+
+	var unmarshal Nullable[Date]
+	assert.NoError(t, json.Unmarshal(data, &unmarshal))
+	assert.Equal(t, d, unmarshal)
+
+	var null Nullable[Date]
+	assert.NoError(t, json.Unmarshal(nullJSON, &null))
+	assert.False(t, null.Valid)
+
+	var invalid Nullable[Date]
+	assert.Error(t, json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &invalid))
+}
+
+func Test_Date_nullable_text(t *testing.T) {
+	d := Value(Date{2012, time.December, 21})
+	txt, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2012-12-21", string(txt))
+
+	var unmarshal Nullable[Date]
+	assert.NoError(t, unmarshal.UnmarshalText(txt))
+	assert.Equal(t, d, unmarshal)
+}
+
+func Test_Date_nullable_scan(t *testing.T) {
+	expected := Value(Date{2012, time.December, 21})
+
+	var d Nullable[Date]
+	assert.NoError(t, d.Scan("2012-12-21"))
+	assert.Equal(t, expected, d)
+
+	assert.NoError(t, d.Scan([]byte("2012-12-21 00:00:00")))
+	assert.Equal(t, expected, d)
+
+	assert.NoError(t, d.Scan(timeValue1))
+	assert.Equal(t, expected, d)
+
+	assert.NoError(t, d.Scan(nil))
+	assert.False(t, d.Valid)
+
+	assert.Error(t, d.Scan(int64(42)))
+	assert.False(t, d.Valid)
+
+	value, err := expected.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2012-12-21", value)
+}
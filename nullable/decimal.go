@@ -0,0 +1,363 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number with arbitrary precision, like the SQL NUMERIC type.
+// It keeps its scale, the number of digits after the decimal point, so 12.50 is written as 12.50.
+// It is written as a JSON number, and read from JSON numbers and strings without going through float64.
+// The zero Decimal is 0
+type Decimal struct {
+	// unscaled is never modified after creation, so a Decimal can be copied. nil means zero
+	unscaled *big.Int
+	scale    int32
+}
+
+// RoundingMode tells how to round a Decimal when digits are dropped
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, and away from zero on ties
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest neighbour, and to the even neighbour on ties, also called banker's rounding
+	RoundHalfEven
+	// RoundHalfDown rounds to the nearest neighbour, and toward zero on ties
+	RoundHalfDown
+	// RoundDown rounds toward zero, truncating the dropped digits
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity
+	RoundCeiling
+)
+
+// The limits of the scale follow PostgreSQL NUMERIC, so parsing huge exponents cannot use unbounded memory
+const (
+	maxDecimalScale = 16383
+	minDecimalScale = -131072
+)
+
+// ErrDivisionByZero is returned when dividing a Decimal by zero
+var ErrDivisionByZero = errors.New("null: division by zero")
+
+var bigTen = big.NewInt(10)
+
+// NewDecimal Create a Decimal with the value unscaled * 10^-scale, like NewDecimal(1250, 2) for 12.50
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(unscaled), scale)
+}
+
+// NewDecimalFromBigInt Create a Decimal with the value unscaled * 10^-scale
+func NewDecimalFromBigInt(unscaled *big.Int, scale int32) Decimal {
+	return newDecimal(new(big.Int).Set(unscaled), scale)
+}
+
+// DecimalFromFloat Create a Decimal with the shortest decimal representation of f that parses back to f
+func DecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("null: cannot convert %v to Decimal", f)
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal Parse a decimal number like 12.50, -0.001 or 1.5e3
+func ParseDecimal(s string) (Decimal, error) {
+	invalid := errors.New("null: could not parse decimal: " + s)
+
+	str, negative := cutSign(s)
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(str), "e")
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	digits := whole + fraction
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		if strings.EqualFold(str, "NaN") || strings.EqualFold(str, "Infinity") {
+			return Decimal{}, errors.New("null: Decimal cannot hold " + s)
+		}
+		return Decimal{}, invalid
+	}
+
+	scale := int64(len(fraction))
+	if hasExponent {
+		exp, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil {
+			return Decimal{}, invalid
+		}
+		scale -= exp
+	}
+	if scale > maxDecimalScale || scale < minDecimalScale {
+		return Decimal{}, errors.New("null: decimal out of range: " + s)
+	}
+
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return newDecimal(unscaled, int32(scale)), nil
+}
+
+// newDecimal Create a Decimal taking ownership of unscaled, keeping the scale non-negative
+func newDecimal(unscaled *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-int64(scale)))
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// Unscaled Get the value without decimal point, so the Decimal is Unscaled * 10^-Scale
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale Get the number of digits after the decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign Get -1 if d is negative, 0 if it is zero and 1 if it is positive
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero Check if d is zero, with any scale
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Compare Compare d to other, returning -1 if d is less than other, 1 if it is greater, and 0 if they are equal
+func (d Decimal) Compare(other Decimal) int {
+	a, b := align(d, other)
+	return a.Cmp(b)
+}
+
+// Equal Check if d and other are the same number, ignoring the scale, so 1.5 equals 1.50
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Compare(other) == 0
+}
+
+// Neg Get -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs Get the absolute value of d
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add Get d + other, with the larger scale of the two
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b := align(d, other)
+	return Decimal{unscaled: a.Add(a, b), scale: max(d.scale, other.scale)}
+}
+
+// Sub Get d - other, with the larger scale of the two
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b := align(d, other)
+	return Decimal{unscaled: a.Sub(a, b), scale: max(d.scale, other.scale)}
+}
+
+// Mul Get d * other, with the sum of the scales
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Quo Get d / other with the given scale, rounded with mode
+func (d Decimal) Quo(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+
+	// d / other = (d.unscaled * 10^shift / other.unscaled) * 10^-scale
+	shift := int64(scale) + int64(other.scale) - int64(d.scale)
+	numerator := new(big.Int).Set(d.int())
+	denominator := new(big.Int).Set(other.int())
+	if shift > 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+	if denominator.Sign() < 0 {
+		numerator.Neg(numerator)
+		denominator.Neg(denominator)
+	}
+	return newDecimal(roundQuo(numerator, denominator, mode), scale), nil
+}
+
+// Round Get d rounded to the given scale with mode. A larger scale than the one of d adds zeros,
+// and a negative scale rounds to tens, hundreds and so on
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(int64(scale-d.scale))), scale: scale}
+	}
+	return newDecimal(roundQuo(d.int(), pow10(int64(d.scale)-int64(scale)), mode), scale)
+}
+
+// Rat Get d as a big.Rat
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(int64(d.scale)))
+}
+
+// Float64 Get the float64 nearest to d
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// String Format d without exponent, with as many digits after the decimal point as its scale
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+
+	var sb strings.Builder
+	if d.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	if d.scale == 0 {
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	if missing := int(d.scale) - len(digits) + 1; missing > 0 {
+		digits = strings.Repeat("0", missing) + digits
+	}
+	point := len(digits) - int(d.scale)
+	sb.WriteString(digits[:point])
+	sb.WriteByte('.')
+	sb.WriteString(digits[point:])
+	return sb.String()
+}
+
+// MarshalJSON Write d as a JSON number with all its digits
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON Read d from a JSON number or a JSON string holding a number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	str := string(data)
+	if str == "null" {
+		return nil
+	}
+	if strings.HasPrefix(str, `"`) {
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("null: could not unmarshal decimal: %w", err)
+		}
+	}
+	decimal, err := ParseDecimal(str)
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	decimal, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading NUMERIC text, integers and floats
+func (d *Decimal) Scan(src any) error {
+	var decimal Decimal
+	var err error
+	switch s := src.(type) {
+	case string:
+		decimal, err = ParseDecimal(s)
+	case []byte:
+		decimal, err = ParseDecimal(string(s))
+	case int64:
+		decimal = NewDecimal(s, 0)
+	case float64:
+		decimal, err = DecimalFromFloat(s)
+	default:
+		return fmt.Errorf("null: cannot scan %T into Decimal", src)
+	}
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
+
+// Value Implement driver.Valuer, writing d as NUMERIC text
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// align Get the unscaled values of a and b at the larger scale of the two, as new big.Int values
+func align(a, b Decimal) (*big.Int, *big.Int) {
+	x, y := new(big.Int).Set(a.int()), new(big.Int).Set(b.int())
+	if a.scale < b.scale {
+		x.Mul(x, pow10(int64(b.scale-a.scale)))
+	} else if b.scale < a.scale {
+		y.Mul(y, pow10(int64(a.scale-b.scale)))
+	}
+	return x, y
+}
+
+// roundQuo Get numerator / denominator rounded with mode. The denominator must be positive
+func roundQuo(numerator, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	negative := numerator.Sign() < 0
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	versusHalf := half.Cmp(denominator)
+
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = versusHalf >= 0
+	case RoundHalfEven:
+		away = versusHalf > 0 || (versusHalf == 0 && quotient.Bit(0) == 1)
+	case RoundHalfDown:
+		away = versusHalf > 0
+	case RoundDown:
+		away = false
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = negative
+	case RoundCeiling:
+		away = !negative
+	}
+
+	if away {
+		if negative {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
@@ -0,0 +1,211 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	d, err := ParseDecimal(s)
+	assert.NoError(t, err, s)
+	return d
+}
+
+func Test_Decimal_parse(t *testing.T) {
+	tests := map[string]string{
+		"12.50":  "12.50",
+		"-0.001": "-0.001",
+		"+7":     "7",
+		"0":      "0",
+		".5":     "0.5",
+		"1.5e3":  "1500",
+		"1.5E-3": "0.0015",
+		"-25e-1": "-2.5",
+		"0.00":   "0.00",
+		"1e0":    "1",
+		"123456789012345678901234567890.123456789": "123456789012345678901234567890.123456789",
+	}
+	for str, expected := range tests {
+		assert.Equal(t, expected, mustDecimal(t, str).String(), str)
+	}
+
+	for _, invalid := range []string{"", "-", ".", "1.2.3", "abc", "1e", "1e1.5", "NaN", "Infinity", "1e99999999", " 1"} {
+		_, err := ParseDecimal(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func Test_Decimal_String(t *testing.T) {
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "12.50", NewDecimal(1250, 2).String())
+	assert.Equal(t, "-0.05", NewDecimal(-5, 2).String())
+	assert.Equal(t, "1200", NewDecimal(12, -2).String())
+	assert.Equal(t, "0.000", NewDecimal(0, 3).String())
+	assert.Equal(t, "-123.4", NewDecimalFromBigInt(big.NewInt(-1234), 1).String())
+}
+
+func Test_Decimal_arithmetic(t *testing.T) {
+	a, b := mustDecimal(t, "12.50"), mustDecimal(t, "0.125")
+	assert.Equal(t, "12.625", a.Add(b).String())
+	assert.Equal(t, "12.375", a.Sub(b).String())
+	assert.Equal(t, "1.56250", a.Mul(b).String())
+	assert.Equal(t, "-12.50", a.Neg().String())
+	assert.Equal(t, "12.50", a.Neg().Abs().String())
+
+	// 0.1 + 0.2 is exact, unlike float64
+	assert.Equal(t, "0.3", mustDecimal(t, "0.1").Add(mustDecimal(t, "0.2")).String())
+
+	quo, err := mustDecimal(t, "10").Quo(mustDecimal(t, "3"), 4, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, "3.3333", quo.String())
+	quo, err = mustDecimal(t, "-2").Quo(mustDecimal(t, "3"), 2, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.67", quo.String())
+	quo, err = mustDecimal(t, "1.5").Quo(mustDecimal(t, "-0.25"), 0, RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, "-6", quo.String())
+
+	_, err = a.Quo(Decimal{}, 2, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func Test_Decimal_Round(t *testing.T) {
+	type test struct {
+		value    string
+		mode     RoundingMode
+		expected string
+	}
+	tests := []test{
+		{"2.5", RoundHalfUp, "3"}, {"-2.5", RoundHalfUp, "-3"}, {"2.4", RoundHalfUp, "2"},
+		{"2.5", RoundHalfEven, "2"}, {"3.5", RoundHalfEven, "4"}, {"-2.5", RoundHalfEven, "-2"}, {"2.51", RoundHalfEven, "3"},
+		{"2.5", RoundHalfDown, "2"}, {"2.51", RoundHalfDown, "3"}, {"-2.5", RoundHalfDown, "-2"},
+		{"2.9", RoundDown, "2"}, {"-2.9", RoundDown, "-2"},
+		{"2.1", RoundUp, "3"}, {"-2.1", RoundUp, "-3"},
+		{"2.9", RoundFloor, "2"}, {"-2.1", RoundFloor, "-3"},
+		{"2.1", RoundCeiling, "3"}, {"-2.9", RoundCeiling, "-2"},
+		{"2", RoundCeiling, "2"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, mustDecimal(t, test.value).Round(0, test.mode).String(), test)
+	}
+
+	assert.Equal(t, "1.2300", mustDecimal(t, "1.23").Round(4, RoundHalfUp).String())
+	assert.Equal(t, "1.24", mustDecimal(t, "1.235").Round(2, RoundHalfUp).String())
+	assert.Equal(t, "1200", mustDecimal(t, "1234.5").Round(-2, RoundHalfUp).String())
+}
+
+func Test_Decimal_Compare(t *testing.T) {
+	assert.True(t, mustDecimal(t, "1.5").Equal(mustDecimal(t, "1.50")))
+	assert.True(t, Decimal{}.Equal(mustDecimal(t, "0.00")))
+	assert.Equal(t, -1, mustDecimal(t, "1.49").Compare(mustDecimal(t, "1.5")))
+	assert.Equal(t, 1, mustDecimal(t, "-1").Compare(mustDecimal(t, "-1.01")))
+	assert.Equal(t, 1.25, mustDecimal(t, "1.25").Float64())
+	assert.Equal(t, big.NewRat(1, 8), mustDecimal(t, "0.125").Rat())
+}
+
+func Test_Decimal_Nullable_JSON(t *testing.T) {
+	var n Nullable[Decimal]
+	assert.NoError(t, json.Unmarshal([]byte(`12345678901234567890.01`), &n))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "12345678901234567890.01", n.Data.String())
+
+	assert.NoError(t, json.Unmarshal([]byte(`"0.10"`), &n))
+	assert.Equal(t, "0.10", n.Data.String())
+
+	data, err := json.Marshal(n)
+	assert.NoError(t, err)
+	assert.Equal(t, `0.10`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &n))
+	assert.False(t, n.Valid)
+	data, err = json.Marshal(n)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"ten"`), &n))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &n))
+}
+
+func Test_Decimal_Nullable_text(t *testing.T) {
+	var n Nullable[Decimal]
+	assert.NoError(t, n.UnmarshalText([]byte("-3.14")))
+	assert.Equal(t, Value(NewDecimal(-314, 2)), n)
+
+	text, err := n.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "-3.14", string(text))
+
+	assert.NoError(t, n.UnmarshalText([]byte("")))
+	assert.False(t, n.Valid)
+}
+
+func Test_Decimal_Nullable_Scan(t *testing.T) {
+	tests := []struct {
+		src      any
+		expected string
+	}{
+		{"1234.50", "1234.50"},
+		{[]byte("-0.0001"), "-0.0001"},
+		{int64(42), "42"},
+		{float64(0.1), "0.1"},
+	}
+	for _, test := range tests {
+		var n Nullable[Decimal]
+		assert.NoError(t, n.Scan(test.src), test.src)
+		assert.True(t, n.Valid)
+		assert.Equal(t, test.expected, n.Data.String())
+	}
+
+	var n Nullable[Decimal]
+	assert.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	assert.Error(t, n.Scan(true))
+	assert.Error(t, n.Scan(math.NaN()))
+	assert.Error(t, n.Scan("NaN"))
+
+	value, err := Value(NewDecimal(1250, 2)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "12.50", value)
+	value, err = Null[Decimal]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test_Decimal_Nullable_Equal(t *testing.T) {
+	assert.True(t, Value(mustDecimal(t, "1.5")).Equal(Value(mustDecimal(t, "1.50"))))
+	assert.False(t, Value(mustDecimal(t, "1.5")).Equal(Value(mustDecimal(t, "1.51"))))
+	assert.False(t, Value(Decimal{}).Equal(Null[Decimal]()))
+	assert.Equal(t, Value(true), Eq(Value(mustDecimal(t, "2")), Value(mustDecimal(t, "2.000"))))
+	assert.True(t, Value(mustDecimal(t, "0.00")).IsZero())
+	assert.False(t, Value(mustDecimal(t, "0.01")).IsZero())
+}
+
+// caseless has Equal and IsZero methods, which Nullable does not use
+type caseless string
+
+func (c caseless) Equal(other caseless) bool {
+	return strings.EqualFold(string(c), string(other))
+}
+
+func (c caseless) IsZero() bool {
+	return true
+}
+
+func Test_Nullable_Equal_other_methods(t *testing.T) {
+	assert.False(t, Value(caseless("a")).Equal(Value(caseless("A"))))
+	assert.False(t, Value(caseless("a")).IsZero())
+}
+
+func Test_Decimal_Array(t *testing.T) {
+	var a Array[Decimal]
+	assert.NoError(t, a.Scan("{1.10,NULL,-2}"))
+	assert.Equal(t, Array[Decimal]{Value(NewDecimal(110, 2)), Null[Decimal](), Value(NewDecimal(-2, 0))}, a)
+
+	value, err := a.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{1.10,NULL,-2}", value)
+}
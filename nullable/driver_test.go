@@ -0,0 +1,136 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+)
+
+// testDriver is a database/sql driver returning fixed result sets, registered by query
+type testDriver struct{}
+
+type testResult struct {
+	columns []string
+	rows    [][]driver.Value
+
+	// count and generate give generated rows instead of fixed ones, for large result sets
+	count    int
+	generate func(i int, dest []driver.Value)
+}
+
+var (
+	testResultsMu sync.Mutex
+	testResults   = map[string]testResult{}
+)
+
+func init() {
+	sql.Register("nullable-test", testDriver{})
+}
+
+// queryTestRows Query a test database returning the given columns and rows
+func queryTestRows(t testing.TB, columns []string, rows ...[]driver.Value) *sql.Rows {
+	t.Helper()
+	return queryTestResult(t, testResult{columns: columns, rows: rows})
+}
+
+// queryGeneratedRows Query a test database returning count rows, filled by generate
+func queryGeneratedRows(t testing.TB, columns []string, count int, generate func(i int, dest []driver.Value)) *sql.Rows {
+	t.Helper()
+	return queryTestResult(t, testResult{columns: columns, count: count, generate: generate})
+}
+
+func queryTestResult(t testing.TB, result testResult) *sql.Rows {
+	t.Helper()
+	testResultsMu.Lock()
+	testResults[t.Name()] = result
+	testResultsMu.Unlock()
+
+	db, err := sql.Open("nullable-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	rows, err := db.Query(t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func (testDriver) Open(string) (driver.Conn, error) {
+	return testConn{}, nil
+}
+
+type testConn struct{}
+
+func (testConn) Prepare(query string) (driver.Stmt, error) {
+	testResultsMu.Lock()
+	defer testResultsMu.Unlock()
+	result, ok := testResults[query]
+	if !ok {
+		return nil, errors.New("unknown test query " + query)
+	}
+	return testStmt{result: result}, nil
+}
+
+func (testConn) Close() error {
+	return nil
+}
+
+func (testConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type testStmt struct {
+	result testResult
+}
+
+func (testStmt) Close() error {
+	return nil
+}
+
+func (testStmt) NumInput() int {
+	return -1
+}
+
+func (testStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+func (s testStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &testRows{result: s.result}, nil
+}
+
+type testRows struct {
+	result testResult
+	next   int
+}
+
+func (r *testRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *testRows) Close() error {
+	return nil
+}
+
+func (r *testRows) Next(dest []driver.Value) error {
+	if r.result.generate != nil {
+		if r.next >= r.result.count {
+			return io.EOF
+		}
+		r.result.generate(r.next, dest)
+		r.next++
+		return nil
+	}
+	if r.next >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+	return nil
+}
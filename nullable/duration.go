@@ -0,0 +1,303 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration written as an ISO-8601 duration, like PT1H30M, in JSON, text and SQL.
+// It can be scanned from ISO-8601 and PostgreSQL interval strings, as long as they do not use years or months,
+// which have no fixed length. A day is always 24 hours
+type Duration time.Duration
+
+var errDurationOverflow = errors.New("null: duration out of range")
+
+// ParseDuration Parse an ISO-8601 duration like PT1H30M, P1DT12H or -PT0.5S
+func ParseDuration(s string) (Duration, error) {
+	invalid := errors.New("null: could not parse ISO-8601 duration: " + s)
+
+	str, negative := cutSign(s)
+	if !strings.HasPrefix(str, "P") || len(str) < 3 {
+		return 0, invalid
+	}
+	str = str[1:]
+
+	var total time.Duration
+	inTime := false
+	for str != "" {
+		if str[0] == 'T' {
+			if inTime || len(str) == 1 {
+				return 0, invalid
+			}
+			inTime = true
+			str = str[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(str, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, invalid
+		}
+		number := strings.ReplaceAll(str[:end], ",", ".")
+
+		var unit time.Duration
+		switch designator := str[end]; {
+		case designator == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case designator == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case designator == 'H' && inTime:
+			unit = time.Hour
+		case designator == 'M' && inTime:
+			unit = time.Minute
+		case designator == 'S' && inTime:
+			unit = time.Second
+		case designator == 'Y', designator == 'M':
+			return 0, errors.New("null: years and months have no fixed duration: " + s)
+		default:
+			return 0, invalid
+		}
+
+		part, err := scaleDecimal(number, unit)
+		if err != nil {
+			return 0, err
+		}
+		// Negative parts are added one by one, since the smallest duration has no positive counterpart
+		if negative {
+			part = -part
+		}
+		if total, err = addDuration(total, part); err != nil {
+			return 0, err
+		}
+		str = str[end+1:]
+	}
+	return Duration(total), nil
+}
+
+// ParseInterval Parse a PostgreSQL interval in the postgres output style, like 1 day 02:03:04.5 or -00:30:00,
+// or an ISO-8601 duration as given by the iso_8601 output style
+func ParseInterval(s string) (Duration, error) {
+	trimmed := strings.TrimSpace(s)
+	if unsigned, _ := cutSign(trimmed); strings.HasPrefix(unsigned, "P") {
+		return ParseDuration(trimmed)
+	}
+
+	invalid := errors.New("null: could not parse interval: " + s)
+	fields := strings.Fields(trimmed)
+	if len(fields) == 0 {
+		return 0, invalid
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var part time.Duration
+		var err error
+		if strings.Contains(fields[i], ":") {
+			part, err = parseIntervalClock(fields[i])
+		} else if i+1 < len(fields) {
+			part, err = parseIntervalUnit(fields[i], fields[i+1])
+			i++
+		} else {
+			return 0, invalid
+		}
+		if err != nil {
+			return 0, err
+		}
+		if total, err = addDuration(total, part); err != nil {
+			return 0, err
+		}
+	}
+	return Duration(total), nil
+}
+
+// Duration Get the value as a time.Duration
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// String Format the duration in ISO-8601, like PT1H30M
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var sb strings.Builder
+	value := time.Duration(d)
+	if value < 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteString("PT")
+
+	abs := uint64(value)
+	if value < 0 {
+		abs = -abs
+	}
+	hours := abs / uint64(time.Hour)
+	remainder := abs % uint64(time.Hour)
+	minutes := remainder / uint64(time.Minute)
+	nanos := remainder % uint64(time.Minute)
+
+	if hours > 0 {
+		sb.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		sb.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if nanos > 0 {
+		sb.WriteString(strconv.FormatUint(nanos/uint64(time.Second), 10))
+		if fraction := nanos % uint64(time.Second); fraction > 0 {
+			sb.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", fraction), "0"))
+		}
+		sb.WriteByte('S')
+	}
+	return sb.String()
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	duration, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = duration
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading an ISO-8601 duration or a PostgreSQL interval
+func (d *Duration) Scan(src any) error {
+	var str string
+	switch s := src.(type) {
+	case string:
+		str = s
+	case []byte:
+		str = string(s)
+	default:
+		return fmt.Errorf("null: cannot scan %T into Duration", src)
+	}
+
+	duration, err := ParseInterval(str)
+	if err != nil {
+		return err
+	}
+	*d = duration
+	return nil
+}
+
+// Value Implement driver.Valuer, writing the duration in ISO-8601
+func (d Duration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func parseIntervalClock(field string) (time.Duration, error) {
+	invalid := errors.New("null: could not parse interval time: " + field)
+
+	str, negative := cutSign(field)
+	parts := strings.Split(str, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, invalid
+	}
+
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	var total time.Duration
+	for i, part := range parts {
+		if part == "" || (i < len(parts)-1 && strings.Contains(part, ".")) {
+			return 0, invalid
+		}
+		value, err := scaleDecimal(part, units[i])
+		if err != nil {
+			return 0, err
+		}
+		if negative {
+			value = -value
+		}
+		if total, err = addDuration(total, value); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+func parseIntervalUnit(number, unit string) (time.Duration, error) {
+	var scale time.Duration
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "week":
+		scale = 7 * 24 * time.Hour
+	case "day":
+		scale = 24 * time.Hour
+	case "hour":
+		scale = time.Hour
+	case "min", "minute":
+		scale = time.Minute
+	case "sec", "second":
+		scale = time.Second
+	case "year", "mon", "month", "decade", "century", "millennium":
+		return 0, errors.New("null: years and months have no fixed duration: " + number + " " + unit)
+	default:
+		return 0, errors.New("null: unknown interval unit: " + unit)
+	}
+
+	str, negative := cutSign(number)
+	value, err := scaleDecimal(str, scale)
+	if negative {
+		value = -value
+	}
+	return value, err
+}
+
+// scaleDecimal Get number, a decimal number without sign, multiplied by unit
+func scaleDecimal(number string, unit time.Duration) (time.Duration, error) {
+	invalid := errors.New("null: invalid duration number: " + number)
+
+	whole, fraction, _ := strings.Cut(number, ".")
+	if whole == "" && fraction == "" {
+		return 0, invalid
+	}
+
+	var value time.Duration
+	if whole != "" {
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		if n > int64(math.MaxInt64/unit) {
+			return 0, errDurationOverflow
+		}
+		value = time.Duration(n) * unit
+	}
+
+	scale := unit
+	for _, digit := range fraction {
+		if digit < '0' || digit > '9' {
+			return 0, invalid
+		}
+		scale /= 10
+		value += time.Duration(digit-'0') * scale
+	}
+	if value < 0 {
+		return 0, errDurationOverflow
+	}
+	return value, nil
+}
+
+func addDuration(a, b time.Duration) (time.Duration, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, errDurationOverflow
+	}
+	return sum, nil
+}
+
+func cutSign(s string) (string, bool) {
+	if strings.HasPrefix(s, "-") {
+		return s[1:], true
+	}
+	return strings.TrimPrefix(s, "+"), false
+}
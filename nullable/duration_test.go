@@ -0,0 +1,115 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func Test_Duration_parse(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT1H30M":        90 * time.Minute,
+		"PT0S":           0,
+		"P1D":            24 * time.Hour,
+		"P1DT12H":        36 * time.Hour,
+		"P2W":            14 * 24 * time.Hour,
+		"PT0.5S":         500 * time.Millisecond,
+		"PT1,5M":         90 * time.Second,
+		"-PT1M":          -time.Minute,
+		"+PT1M":          time.Minute,
+		"PT1.000000001S": time.Second + time.Nanosecond,
+	}
+	for str, expected := range tests {
+		d, err := ParseDuration(str)
+		assert.NoError(t, err, str)
+		assert.Equal(t, Duration(expected), d, str)
+	}
+
+	for _, invalid := range []string{"", "P", "PT", "1H", "PT1D", "P1H", "P1Y", "P1M", "PT1H1", "PTM", "PT9999999999H"} {
+		_, err := ParseDuration(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func Test_Duration_string(t *testing.T) {
+	assert.Equal(t, "PT1H30M", Duration(90*time.Minute).String())
+	assert.Equal(t, "PT0S", Duration(0).String())
+	assert.Equal(t, "PT36H", Duration(36*time.Hour).String())
+	assert.Equal(t, "-PT1.5S", Duration(-1500*time.Millisecond).String())
+	assert.Equal(t, "PT0.000000001S", Duration(1).String())
+	assert.Equal(t, "-PT2562047H47M16.854775808S", Duration(math.MinInt64).String())
+
+	for _, d := range []Duration{math.MaxInt64, math.MinInt64, math.MinInt64 + 1, Duration(-25*time.Hour - time.Nanosecond)} {
+		parsed, err := ParseDuration(d.String())
+		assert.NoError(t, err)
+		assert.Equal(t, d, parsed)
+
+		text, err := Value(d).MarshalText()
+		assert.NoError(t, err)
+		var n Nullable[Duration]
+		assert.NoError(t, n.UnmarshalText(text))
+		assert.Equal(t, Value(d), n)
+	}
+
+	interval, err := ParseInterval("-2562047:47:16.854775808")
+	assert.NoError(t, err)
+	assert.Equal(t, Duration(math.MinInt64), interval)
+
+	_, err = ParseDuration("PT2562047H47M16.854775808S")
+	assert.Error(t, err)
+}
+
+func Test_Duration_parse_interval(t *testing.T) {
+	tests := map[string]time.Duration{
+		"01:30:00":          90 * time.Minute,
+		"-00:00:01.5":       -1500 * time.Millisecond,
+		"1 day 02:03:04":    26*time.Hour + 3*time.Minute + 4*time.Second,
+		"-1 days +02:00:00": -22 * time.Hour,
+		"3 days":            72 * time.Hour,
+		"1 hour 30 mins":    90 * time.Minute,
+		"838:59:59":         838*time.Hour + 59*time.Minute + 59*time.Second,
+		"PT1H":              time.Hour,
+		"2 weeks 1 sec":     14*24*time.Hour + time.Second,
+	}
+	for str, expected := range tests {
+		d, err := ParseInterval(str)
+		assert.NoError(t, err, str)
+		assert.Equal(t, Duration(expected), d, str)
+	}
+
+	for _, invalid := range []string{"", "1 year", "2 mons 01:00:00", "1", "1 fortnight", "1:2:3:4"} {
+		_, err := ParseInterval(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func Test_Duration_nullable(t *testing.T) {
+	d := Value(Duration(90 * time.Minute))
+	data, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"PT1H30M"`, string(data))
+
+	var unmarshal Nullable[Duration]
+	assert.NoError(t, json.Unmarshal(data, &unmarshal))
+	assert.Equal(t, d, unmarshal)
+
+	txt, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT1H30M", string(txt))
+
+	var scanned Nullable[Duration]
+	assert.NoError(t, scanned.Scan("01:30:00"))
+	assert.Equal(t, d, scanned)
+	assert.NoError(t, scanned.Scan([]byte("PT1H30M")))
+	assert.Equal(t, d, scanned)
+	assert.Error(t, scanned.Scan(int64(5)))
+	assert.False(t, scanned.Valid)
+
+	value, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT1H30M", value)
+
+	assert.Equal(t, 90*time.Minute, d.Data.Duration())
+}
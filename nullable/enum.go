@@ -0,0 +1,130 @@
+package nullable
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EnumError is returned when decoding, scanning or unmarshalling a value that is not a member of a registered enum
+type EnumError struct {
+	Type  string
+	Value any
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("null: %v is not a valid %s", e.Value, e.Type)
+}
+
+// EnumMember is a value of an enum with its symbolic name
+type EnumMember[T any] struct {
+	Value T
+	Name  string
+}
+
+// enumInfo is a registered enum with the type parameter removed, so it can be kept in the registry
+type enumInfo interface {
+	contains(value any) bool
+	name(value any) (string, bool)
+	byName(name string) (any, bool)
+}
+
+type enum[T comparable] struct {
+	members []EnumMember[T]
+	names   map[T]string
+	values  map[string]T
+}
+
+// enums holds the registered enums by reflect.Type
+var enums sync.Map
+
+// RegisterEnum Register the valid values of T. Nullable[T] then rejects other values with an *EnumError
+// when unmarshalling JSON or text and when scanning.
+// The returned function restores the enum that was registered before, which is useful in tests
+func RegisterEnum[T comparable](values ...T) (restore func()) {
+	members := make([]EnumMember[T], len(values))
+	for i, value := range values {
+		members[i] = EnumMember[T]{Value: value}
+	}
+	return RegisterNamedEnum(members...)
+}
+
+// RegisterNamedEnum Register the valid values of T with their symbolic names, like for integer enums.
+// Besides validating like RegisterEnum, the names are accepted when unmarshalling JSON strings and text,
+// and String of Nullable[T] prints the name. The returned function restores the enum that was registered before
+func RegisterNamedEnum[T comparable](members ...EnumMember[T]) (restore func()) {
+	e := &enum[T]{members: members, names: map[T]string{}, values: map[string]T{}}
+	for _, member := range members {
+		e.names[member.Value] = member.Name
+		if member.Name != "" {
+			e.values[member.Name] = member.Value
+		}
+	}
+	return registerCodec[T](&enums, enumInfo(e))
+}
+
+// EnumMembers Get the registered members of T in registration order, or nil if T is not a registered enum
+func EnumMembers[T comparable]() []EnumMember[T] {
+	info := lookupEnum[T]()
+	if info == nil {
+		return nil
+	}
+	return append([]EnumMember[T]{}, info.(*enum[T]).members...)
+}
+
+// EnumValues Get the registered values of T in registration order, or nil if T is not a registered enum
+func EnumValues[T comparable]() []T {
+	members := EnumMembers[T]()
+	if members == nil {
+		return nil
+	}
+	values := make([]T, len(members))
+	for i, member := range members {
+		values[i] = member.Value
+	}
+	return values
+}
+
+func lookupEnum[T any]() enumInfo {
+	return planOf[T]().enum
+}
+
+func lookupEnumName[T any](name string) (T, bool) {
+	var ref T
+	info := lookupEnum[T]()
+	if info == nil {
+		return ref, false
+	}
+	value, ok := info.byName(name)
+	if !ok {
+		return ref, false
+	}
+	return value.(T), true
+}
+
+// validateEnum Check that the data is a member of T if it is a registered enum, and set the Nullable to NULL if not
+func (n *Nullable[T]) validateEnum() error {
+	if !n.Valid {
+		return nil
+	}
+	info := lookupEnum[T]()
+	if info == nil || info.contains(n.Data) {
+		return nil
+	}
+	n.Valid = false
+	return &EnumError{Type: fmt.Sprintf("%T", n.Data), Value: n.Data}
+}
+
+func (e *enum[T]) contains(value any) bool {
+	_, ok := e.names[value.(T)]
+	return ok
+}
+
+func (e *enum[T]) name(value any) (string, bool) {
+	name, ok := e.names[value.(T)]
+	return name, ok && name != ""
+}
+
+func (e *enum[T]) byName(name string) (any, bool) {
+	value, ok := e.values[name]
+	return value, ok
+}
@@ -0,0 +1,124 @@
+package nullable
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type color string
+
+type priority int
+
+const (
+	priorityLow priority = iota + 1
+	priorityHigh
+)
+
+func registerTestEnums() func() {
+	restoreColor := RegisterEnum[color]("red", "green")
+	restorePriority := RegisterNamedEnum(EnumMember[priority]{priorityLow, "low"}, EnumMember[priority]{priorityHigh, "high"})
+	return func() {
+		restorePriority()
+		restoreColor()
+	}
+}
+
+func Test_Enum_UnmarshalJSON(t *testing.T) {
+	defer registerTestEnums()()
+
+	var c Nullable[color]
+	assert.NoError(t, json.Unmarshal([]byte(`"red"`), &c))
+	assert.Equal(t, Value[color]("red"), c)
+
+	err := json.Unmarshal([]byte(`"blue"`), &c)
+	var enumErr *EnumError
+	assert.True(t, errors.As(err, &enumErr))
+	assert.Equal(t, color("blue"), enumErr.Value)
+	assert.EqualError(t, err, "null: blue is not a valid nullable.color")
+	assert.False(t, c.Valid)
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &c))
+	assert.False(t, c.Valid)
+
+	var p Nullable[priority]
+	assert.NoError(t, json.Unmarshal([]byte(`2`), &p))
+	assert.Equal(t, Value(priorityHigh), p)
+	assert.NoError(t, json.Unmarshal([]byte(`"low"`), &p))
+	assert.Equal(t, Value(priorityLow), p)
+	assert.ErrorAs(t, json.Unmarshal([]byte(`7`), &p), &enumErr)
+	assert.Error(t, json.Unmarshal([]byte(`"medium"`), &p))
+}
+
+func Test_Enum_UnmarshalText(t *testing.T) {
+	defer registerTestEnums()()
+
+	var c Nullable[color]
+	assert.NoError(t, c.UnmarshalText([]byte("green")))
+	assert.Equal(t, Value[color]("green"), c)
+	var enumErr *EnumError
+	assert.ErrorAs(t, c.UnmarshalText([]byte("blue")), &enumErr)
+	assert.False(t, c.Valid)
+
+	var p Nullable[priority]
+	assert.NoError(t, p.UnmarshalText([]byte("high")))
+	assert.Equal(t, Value(priorityHigh), p)
+	assert.NoError(t, p.UnmarshalText([]byte("1")))
+	assert.Equal(t, Value(priorityLow), p)
+	assert.ErrorAs(t, p.UnmarshalText([]byte("3")), &enumErr)
+}
+
+func Test_Enum_Scan(t *testing.T) {
+	defer registerTestEnums()()
+
+	var c Nullable[color]
+	assert.NoError(t, c.Scan("red"))
+	assert.Equal(t, Value[color]("red"), c)
+	var enumErr *EnumError
+	assert.ErrorAs(t, c.Scan([]byte("blue")), &enumErr)
+	assert.False(t, c.Valid)
+	assert.NoError(t, c.Scan(nil))
+	assert.False(t, c.Valid)
+
+	var p Nullable[priority]
+	assert.NoError(t, p.Scan(int64(2)))
+	assert.Equal(t, Value(priorityHigh), p)
+	assert.ErrorAs(t, p.Scan(int64(9)), &enumErr)
+	assert.False(t, p.Valid)
+}
+
+func Test_Enum_String(t *testing.T) {
+	defer registerTestEnums()()
+
+	assert.Equal(t, "high", Value(priorityHigh).String())
+	assert.Equal(t, "5", Value(priority(5)).String())
+	assert.Equal(t, "red", Value[color]("red").String())
+}
+
+func Test_Enum_Values(t *testing.T) {
+	defer registerTestEnums()()
+
+	assert.Equal(t, []color{"red", "green"}, EnumValues[color]())
+	assert.Equal(t, []EnumMember[priority]{{priorityLow, "low"}, {priorityHigh, "high"}}, EnumMembers[priority]())
+	assert.Nil(t, EnumValues[string]())
+}
+
+func Test_Enum_unregistered(t *testing.T) {
+	var c Nullable[color]
+	assert.NoError(t, json.Unmarshal([]byte(`"blue"`), &c))
+	assert.Equal(t, Value[color]("blue"), c)
+	assert.NoError(t, c.Scan("purple"))
+	assert.True(t, c.Valid)
+}
+
+func Test_Enum_restore(t *testing.T) {
+	restore := RegisterEnum[color]("red")
+	inner := RegisterEnum[color]("blue")
+	assert.Equal(t, []color{"blue"}, EnumValues[color]())
+
+	inner()
+	assert.Equal(t, []color{"red"}, EnumValues[color]())
+	restore()
+	assert.Nil(t, EnumValues[color]())
+}
@@ -0,0 +1,74 @@
+package nullable
+
+import (
+	"math"
+	"strconv"
+	"sync"
+)
+
+// FloatFormat is how floats are written in text and JSON
+type FloatFormat struct {
+	// Decimals is the number of digits after the decimal point, or -1 for the fewest digits that read back
+	// to the same float
+	Decimals int
+	// LargeExponent and SmallExponent are the powers of ten from which exponent notation is used,
+	// like 1e+21 for 21 and 1e-7 for -6. Zero turns exponent notation off for that side
+	LargeExponent int
+	SmallExponent int
+}
+
+// ShortestFloat is the format of encoding/json, with the fewest digits that read back to the same float,
+// and exponent notation for values from 1e21 and below 1e-6
+var ShortestFloat = shortestFloat
+
+var (
+	floatFormats sync.Map
+	// shortestFloat is the format of float types without a registered format, which can not be changed
+	shortestFloat = FloatFormat{Decimals: -1, LargeExponent: 21, SmallExponent: -6}
+)
+
+// RegisterFloatFormat Use format for MarshalText and MarshalJSON of Nullable[T], instead of ShortestFloat.
+// JSON of named float types is written by encoding/json, so only their text uses the format.
+// The returned function restores the format that was registered before, which is useful in tests
+func RegisterFloatFormat[T ~float32 | ~float64](format FloatFormat) (restore func()) {
+	return registerCodec[T](&floatFormats, &format)
+}
+
+// floatFormatOf Get the format of T, which is only meaningful for float types
+func floatFormatOf[T any]() FloatFormat {
+	if format := planOf[T]().floatFormat; format != nil {
+		return *format
+	}
+	return shortestFloat
+}
+
+// appendFloat Append f, a finite float with bits of precision, to b
+func (format FloatFormat) appendFloat(b []byte, f float64, bits int) []byte {
+	notation := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		large := format.LargeExponent != 0 && !belowPow10(abs, format.LargeExponent, bits)
+		small := format.SmallExponent != 0 && belowPow10(abs, format.SmallExponent, bits)
+		if large || small {
+			notation = 'e'
+		}
+	}
+
+	b = strconv.AppendFloat(b, f, notation, format.Decimals, bits)
+	if notation == 'e' {
+		// Clean up e-09 to e-9, like encoding/json
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
+
+// belowPow10 Check if abs is below 10^exponent, compared with the precision of the float
+func belowPow10(abs float64, exponent int, bits int) bool {
+	limit := math.Pow10(exponent)
+	if bits == 32 {
+		return float32(abs) < float32(limit)
+	}
+	return abs < limit
+}
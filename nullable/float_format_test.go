@@ -0,0 +1,128 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+type celsius float64
+
+func Test_FloatFormat_default(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected string
+	}{
+		{Value(float32(1.2345)), "1.2345"},
+		{Value(float32(0.1)), "0.1"},
+		{Value(1.2345), "1.2345"},
+		{Value(1e300), "1e+300"},
+		{Value(float32(-3e38)), "-3e+38"},
+		{Value(123456789.0), "123456789"},
+		{Value(0.000001), "0.000001"},
+		{Value(0.0000001), "1e-7"},
+		{Value(celsius(21.5)), "21.5"},
+		{Value(celsius(1e21)), "1e+21"},
+	}
+	for _, test := range tests {
+		text, err := test.value.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, string(text))
+	}
+
+	data, err := json.Marshal(Value(float32(1.2345)))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2345", string(data))
+}
+
+func Test_FloatFormat_float32(t *testing.T) {
+	restore := RegisterFloatFormat[float32](FloatFormat{Decimals: 2})
+	defer restore()
+
+	text, err := Value(float32(1.0 / 3)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.33", string(text))
+
+	data, err := Value(float32(2.5)).AppendJSON(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.50", string(data))
+}
+
+func Test_FloatFormat_registered(t *testing.T) {
+	restore := RegisterFloatFormat[float64](FloatFormat{Decimals: 3, LargeExponent: 6})
+	defer restore()
+
+	text, err := Value(2.0).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2.000", string(text))
+
+	data, err := json.Marshal(Value(1234567.0))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.235e+06", string(data))
+
+	// Other float types keep the default format
+	text, err = Value(float32(2)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(text))
+
+	restoreNamed := RegisterFloatFormat[celsius](FloatFormat{Decimals: 1})
+	text, err = Value(celsius(21)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "21.0", string(text))
+
+	restoreNamed()
+	text, err = Value(celsius(21)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "21", string(text))
+}
+
+// randomFloatBits Get finite floats from random bit patterns, which covers all exponents and subnormal numbers
+func randomFloatBits(r *rand.Rand, count int) ([]float32, []float64) {
+	var floats32 []float32
+	var floats64 []float64
+	for len(floats32) < count {
+		if f := math.Float32frombits(r.Uint32()); !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0) {
+			floats32 = append(floats32, f)
+		}
+	}
+	for len(floats64) < count {
+		if f := math.Float64frombits(r.Uint64()); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			floats64 = append(floats64, f)
+		}
+	}
+	return floats32, floats64
+}
+
+func assertFloatRoundTrip[T float32 | float64](t *testing.T, value T) {
+	n := Value(value)
+
+	text, err := n.MarshalText()
+	assert.NoError(t, err)
+	var fromText Nullable[T]
+	assert.NoError(t, fromText.UnmarshalText(text), string(text))
+	assert.Equal(t, n, fromText, string(text))
+
+	data, err := n.MarshalJSON()
+	assert.NoError(t, err)
+	var fromJSON Nullable[T]
+	assert.NoError(t, fromJSON.UnmarshalJSON(data), string(data))
+	assert.Equal(t, n, fromJSON, string(data))
+
+	expected, err := json.Marshal(value)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(data))
+}
+
+func Test_FloatFormat_roundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	floats32, floats64 := randomFloatBits(r, 10_000)
+
+	for _, f := range append(floats32, 0, float32(math.Copysign(0, -1)), math.MaxFloat32, math.SmallestNonzeroFloat32, 0.1, 1.2345) {
+		assertFloatRoundTrip(t, f)
+	}
+	for _, f := range append(floats64, 0, math.Copysign(0, -1), math.MaxFloat64, math.SmallestNonzeroFloat64, 0.1, 1e300) {
+		assertFloatRoundTrip(t, f)
+	}
+}
@@ -0,0 +1,67 @@
+package nullable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MarshalGQL Write the value as a GraphQL scalar, compatible with gqlgen's graphql.Marshaler.
+// A value that cannot be marshalled, like NaN, is written as null. Use MarshalGQLContext to get the error
+func (n Nullable[T]) MarshalGQL(w io.Writer) {
+	if err := n.MarshalGQLContext(context.Background(), w); err != nil {
+		_, _ = w.Write(nullBytes)
+	}
+}
+
+// MarshalGQLContext Write the value as a GraphQL scalar, compatible with gqlgen's graphql.ContextMarshaler,
+// which gqlgen uses instead of MarshalGQL. Nothing is written if the value cannot be marshalled
+func (n Nullable[T]) MarshalGQLContext(_ context.Context, w io.Writer) error {
+	data, err := n.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("null: could not marshal GraphQL value: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// UnmarshalGQL Read a GraphQL input value, compatible with gqlgen's graphql.Unmarshaler
+func (n *Nullable[T]) UnmarshalGQL(v any) error {
+	switch value := v.(type) {
+	case nil:
+		n.Valid = false
+		return nil
+	case json.Number:
+		return n.UnmarshalJSON([]byte(value))
+	case string:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("null: could not unmarshal GraphQL value: %w", err)
+		}
+		if err = n.UnmarshalJSON(data); err == nil {
+			return nil
+		}
+		if value == "" || value == "null" {
+			// Only the GraphQL null is NULL, not the strings that are NULL in text
+			n.Valid = false
+			return err
+		}
+		if textErr := n.UnmarshalText([]byte(value)); textErr != nil {
+			n.Valid = false
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("null: could not unmarshal GraphQL value: %w", err)
+	}
+	return n.UnmarshalJSON(data)
+}
+
+// UnmarshalGQLContext Read a GraphQL input value, compatible with gqlgen's graphql.ContextUnmarshaler
+func (n *Nullable[T]) UnmarshalGQLContext(_ context.Context, v any) error {
+	return n.UnmarshalGQL(v)
+}
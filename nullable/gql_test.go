@@ -0,0 +1,128 @@
+package nullable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func Test_Gql_marshal(t *testing.T) {
+	var buf bytes.Buffer
+	Value(12345).MarshalGQL(&buf)
+	assert.Equal(t, "12345", buf.String())
+
+	buf.Reset()
+	Value("test").MarshalGQL(&buf)
+	assert.Equal(t, `"test"`, buf.String())
+
+	buf.Reset()
+	Value(timeValue1).MarshalGQL(&buf)
+	assert.Equal(t, string(timeJSON), buf.String())
+
+	buf.Reset()
+	Null[int]().MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+
+	buf.Reset()
+	Value(address{AddressLine1: "RoadStreet 1A"}).MarshalGQL(&buf)
+	assert.JSONEq(t, `{"addressLine":"RoadStreet 1A","addressLine2":null,"postNumber":"","city":"","county":null}`, buf.String())
+
+	// MarshalGQL can not return the error
+	buf.Reset()
+	Value(math.NaN()).MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+}
+
+func Test_Gql_marshal_context(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Value(12345).MarshalGQLContext(context.Background(), &buf))
+	assert.Equal(t, "12345", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, Null[int]().MarshalGQLContext(context.Background(), &buf))
+	assert.Equal(t, "null", buf.String())
+
+	// Bad data is not hidden as null
+	buf.Reset()
+	err := Value(math.NaN()).MarshalGQLContext(context.Background(), &buf)
+	assert.EqualError(t, err, "null: could not marshal GraphQL value: json: unsupported value: NaN")
+	assert.Empty(t, buf.String())
+
+	var i Nullable[int]
+	assert.NoError(t, i.UnmarshalGQLContext(context.Background(), json.Number("12345")))
+	assertIntValue(t, i, "json.Number")
+}
+
+func Test_Gql_unmarshal_number(t *testing.T) {
+	var i Nullable[int]
+	assert.NoError(t, i.UnmarshalGQL(json.Number("12345")))
+	assertIntValue(t, i, "json.Number")
+
+	var i64 Nullable[int]
+	assert.NoError(t, i64.UnmarshalGQL(int64(12345)))
+	assertIntValue(t, i64, "int64")
+
+	var fromString Nullable[int]
+	assert.NoError(t, fromString.UnmarshalGQL("12345"))
+	assertIntValue(t, fromString, "string")
+
+	var f Nullable[float64]
+	assert.NoError(t, f.UnmarshalGQL(1.2345))
+	assertFloat64(t, f, "float64")
+
+	var bad Nullable[int]
+	assert.Error(t, bad.UnmarshalGQL(1.2345))
+	assert.False(t, bad.Valid)
+}
+
+func Test_Gql_unmarshal_string(t *testing.T) {
+	var str Nullable[string]
+	assert.NoError(t, str.UnmarshalGQL("test"))
+	assertStr(t, str, "string")
+
+	var blank Nullable[string]
+	assert.NoError(t, blank.UnmarshalGQL(""))
+	assert.True(t, blank.Valid)
+
+	var b Nullable[bool]
+	assert.NoError(t, b.UnmarshalGQL("true"))
+	assertBool(t, b, "bool string")
+
+	var ti Nullable[time.Time]
+	assert.NoError(t, ti.UnmarshalGQL(timeString1))
+	assertTime(t, ti, "time string")
+
+	var bad Nullable[bool]
+	assert.Error(t, bad.UnmarshalGQL("hello world"))
+	assert.False(t, bad.Valid)
+	// The strings are no GraphQL null
+	for _, str := range []string{"", "null"} {
+		i := Value(5)
+		assert.Error(t, i.UnmarshalGQL(str), str)
+		assert.False(t, i.Valid)
+	}
+}
+
+func Test_Gql_unmarshal_null(t *testing.T) {
+	i := Value(10)
+	assert.NoError(t, i.UnmarshalGQL(nil))
+	assert.False(t, i.Valid)
+}
+
+func Test_Gql_unmarshal_map(t *testing.T) {
+	var addr Nullable[address]
+	err := addr.UnmarshalGQL(map[string]any{
+		"addressLine": "RoadStreet 1A",
+		"postNumber":  "1234",
+		"county":      nil,
+	})
+	assert.NoError(t, err)
+	assert.True(t, addr.Valid)
+	assert.Equal(t, "RoadStreet 1A", addr.Data.AddressLine1)
+	assert.Equal(t, "1234", addr.Data.PostNumber)
+	assert.False(t, addr.Data.County.Valid)
+}
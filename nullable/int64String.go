@@ -0,0 +1,70 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// Int64JSONFormat is how Nullable[int64] and Nullable[int] are written to JSON
+type Int64JSONFormat int
+
+const (
+	// Int64AsNumber writes 64-bit integers as JSON numbers
+	Int64AsNumber Int64JSONFormat = iota
+	// Int64AsString writes 64-bit integers as JSON strings, since JavaScript loses precision on numbers above 2^53
+	Int64AsString
+)
+
+var int64JSONFormats sync.Map
+
+// RegisterInt64JSON Use format in MarshalJSON of Nullable[T], instead of Int64AsNumber. An int is only written
+// as a string where it has 64 bits. Both numbers and strings are always read.
+// The returned function restores the format that was registered before, which is useful in tests
+func RegisterInt64JSON[T int | int64](format Int64JSONFormat) (restore func()) {
+	return registerCodec[T](&int64JSONFormats, &format)
+}
+
+// Int64String is an int64 that is always written to JSON as a string, regardless of RegisterInt64JSON, like IDs
+// for JavaScript clients. It is read from both strings and numbers, and is a number in text and SQL
+type Int64String int64
+
+func (i Int64String) MarshalJSON() ([]byte, error) {
+	return appendJSONInt(nil, int64(i), true), nil
+}
+
+func (i *Int64String) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var n Nullable[int64]
+		if err := unmarshalIntStringJson(&n, data); err != nil {
+			return err
+		}
+		*i = Int64String(n.Data)
+		return nil
+	}
+
+	value, ok := parseJSONInt(data, 64)
+	if !ok {
+		return fmt.Errorf("null: %s is not a 64-bit integer", data)
+	}
+	*i = Int64String(value)
+	return nil
+}
+
+func (i Int64String) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+
+// appendJSONInt Append i to b as a JSON number, or as a string if quoted
+func appendJSONInt(b []byte, i int64, quoted bool) []byte {
+	if !quoted {
+		return strconv.AppendInt(b, i, 10)
+	}
+	b = append(b, '"')
+	b = strconv.AppendInt(b, i, 10)
+	return append(b, '"')
+}
@@ -0,0 +1,98 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+type apiObject struct {
+	Id       Nullable[int64]       `json:"id"`
+	ParentId Nullable[Int64String] `json:"parentId"`
+	Count    Nullable[int32]       `json:"count"`
+}
+
+func Test_Int64JSON_number(t *testing.T) {
+	data, err := json.Marshal(apiObject{Id: Value(int64(9007199254740993)), ParentId: Value(Int64String(-9007199254740993)), Count: Value(int32(3))})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":9007199254740993,"parentId":"-9007199254740993","count":3}`, string(data))
+}
+
+func Test_Int64JSON_string(t *testing.T) {
+	defer RegisterInt64JSON[int64](Int64AsString)()
+	defer RegisterInt64JSON[int](Int64AsString)()
+
+	object := apiObject{Id: Value(int64(9223372036854775807)), ParentId: Null[Int64String](), Count: Value(int32(3))}
+	data, err := json.Marshal(object)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"9223372036854775807","parentId":null,"count":3}`, string(data))
+
+	var decoded apiObject
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, object, decoded)
+
+	if strconv.IntSize == 64 {
+		data, err = Value(-42).AppendJSON(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `"-42"`, string(data))
+	}
+
+	// Text is not affected
+	text, err := Value(int64(42)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "42", string(text))
+}
+
+func Test_Int64JSON_decodeBoth(t *testing.T) {
+	for _, data := range []string{
+		`{"id":9007199254740993,"parentId":9007199254740993}`,
+		`{"id":"9007199254740993","parentId":"9007199254740993"}`,
+	} {
+		var object apiObject
+		assert.NoError(t, json.Unmarshal([]byte(data), &object), data)
+		assert.Equal(t, Value(int64(9007199254740993)), object.Id, data)
+		assert.Equal(t, Value(Int64String(9007199254740993)), object.ParentId, data)
+	}
+
+	if strconv.IntSize == 64 {
+		var n Nullable[int]
+		large := int64(4294967296)
+		assert.NoError(t, n.UnmarshalJSON([]byte(`"4294967296"`)))
+		assert.Equal(t, Value(int(large)), n)
+	}
+
+	var object apiObject
+	assert.Error(t, json.Unmarshal([]byte(`{"parentId":"abc"}`), &object))
+	assert.Error(t, json.Unmarshal([]byte(`{"parentId":1.5}`), &object))
+	assert.Error(t, json.Unmarshal([]byte(`{"parentId":"9223372036854775808"}`), &object))
+}
+
+func Test_Int64JSON_per_type(t *testing.T) {
+	defer RegisterInt64JSON[int64](Int64AsString)()
+
+	data, err := json.Marshal(apiObject{Id: Value(int64(7)), Count: Value(int32(3))})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"7","parentId":null,"count":3}`, string(data))
+
+	// Other integer types keep numbers
+	data, err = json.Marshal(Value(7))
+	assert.NoError(t, err)
+	assert.Equal(t, `7`, string(data))
+}
+
+func Test_Int64String_sql(t *testing.T) {
+	value, err := Value(Int64String(42)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), value)
+
+	var n Nullable[Int64String]
+	assert.NoError(t, n.Scan(int64(9007199254740993)))
+	assert.Equal(t, Value(Int64String(9007199254740993)), n)
+
+	text, err := n.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "9007199254740993", string(text))
+	assert.NoError(t, n.UnmarshalText([]byte("7")))
+	assert.Equal(t, Value(Int64String(7)), n)
+}
@@ -87,6 +87,25 @@ func Test_Json_unmarshal_int64_overflow(t *testing.T) {
 	assert.Error(t, err, "err should be present; decoded value overflows int64")
 }
 
+func Test_Json_unmarshal_keeps_data(t *testing.T) {
+	// The data is kept when the JSON is not a valid value of the type
+	n := Value(5)
+	assert.Error(t, n.UnmarshalJSON([]byte("1.5")))
+	assert.Equal(t, 5, n.Data)
+
+	small := Value(int8(5))
+	assert.Error(t, small.UnmarshalJSON([]byte("300")))
+	assert.Equal(t, int8(5), small.Data)
+
+	f := Value(float32(2.5))
+	assert.Error(t, f.UnmarshalJSON([]byte("1e39")))
+	assert.Equal(t, float32(2.5), f.Data)
+
+	big := Value(int64(7))
+	assert.Error(t, big.UnmarshalJSON([]byte("9223372036854775808")))
+	assert.Equal(t, int64(7), big.Data)
+}
+
 func Test_Text_unmarshal_int(t *testing.T) {
 	var i Nullable[int]
 	err := i.UnmarshalText([]byte("12345"))
@@ -106,6 +125,14 @@ func Test_Text_unmarshal_int(t *testing.T) {
 	var invalid Nullable[int]
 	err = invalid.UnmarshalText([]byte("hello world"))
 	assert.Error(t, err)
+
+	// The data is kept when the text is invalid or out of range
+	small := Value(int8(5))
+	err = small.UnmarshalText([]byte("300"))
+	assert.ErrorContains(t, err, "null: couldn't unmarshal text: ")
+	assert.ErrorIs(t, err, strconv.ErrRange)
+	assert.Equal(t, int8(5), small.Data)
+	assert.False(t, small.Valid)
 }
 
 func Test_Json_marshal_int(t *testing.T) {
@@ -0,0 +1,99 @@
+package nullable
+
+import "iter"
+
+// All Iterate over the value, yielding nothing if it is NULL
+func (n Nullable[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n.Valid {
+			yield(n.Data)
+		}
+	}
+}
+
+// Values Iterate over the values in seq, skipping NULL values
+func Values[T any](seq iter.Seq[Nullable[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range seq {
+			if n.Valid && !yield(n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Compact Get the values of a slice of Nullable, with the NULL values removed
+func Compact[T any](s []Nullable[T]) []T {
+	values := make([]T, 0, len(s))
+	for _, n := range s {
+		if n.Valid {
+			values = append(values, n.Data)
+		}
+	}
+	return values
+}
+
+// FromSlice Create a slice of Nullable from a slice of values
+func FromSlice[T any](s []T) []Nullable[T] {
+	if s == nil {
+		return nil
+	}
+	nullables := make([]Nullable[T], len(s))
+	for i, value := range s {
+		nullables[i] = Value(value)
+	}
+	return nullables
+}
+
+// FromPointers Create a slice of Nullable from a slice of pointers, where nil pointers become NULL
+func FromPointers[T any](s []*T) []Nullable[T] {
+	if s == nil {
+		return nil
+	}
+	nullables := make([]Nullable[T], len(s))
+	for i, value := range s {
+		nullables[i] = ValueFromPointer(value)
+	}
+	return nullables
+}
+
+// NullIndexes Get the indexes of the NULL values in a slice of Nullable
+func NullIndexes[T any](s []Nullable[T]) []int {
+	var indexes []int
+	for i, n := range s {
+		if !n.Valid {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// NullsFirst Create a comparison function for slices.SortFunc that orders NULL values before all other values
+func NullsFirst[T any](cmp func(a, b T) int) func(a, b Nullable[T]) int {
+	return func(a, b Nullable[T]) int {
+		switch {
+		case !a.Valid && !b.Valid:
+			return 0
+		case !a.Valid:
+			return -1
+		case !b.Valid:
+			return 1
+		}
+		return cmp(a.Data, b.Data)
+	}
+}
+
+// NullsLast Create a comparison function for slices.SortFunc that orders NULL values after all other values
+func NullsLast[T any](cmp func(a, b T) int) func(a, b Nullable[T]) int {
+	return func(a, b Nullable[T]) int {
+		switch {
+		case !a.Valid && !b.Valid:
+			return 0
+		case !a.Valid:
+			return 1
+		case !b.Valid:
+			return -1
+		}
+		return cmp(a.Data, b.Data)
+	}
+}
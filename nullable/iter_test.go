@@ -0,0 +1,67 @@
+package nullable
+
+import (
+	"cmp"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func Test_Iter_All(t *testing.T) {
+	assert.Equal(t, []int{5}, slices.Collect(Value(5).All()))
+	assert.Empty(t, slices.Collect(Null[int]().All()))
+
+	for range Value(5).All() {
+		break
+	}
+}
+
+func Test_Iter_Values(t *testing.T) {
+	s := []Nullable[int]{Value(1), Null[int](), Value(3), Null[int]()}
+	assert.Equal(t, []int{1, 3}, slices.Collect(Values(slices.Values(s))))
+
+	var first []int
+	for v := range Values(slices.Values(s)) {
+		first = append(first, v)
+		break
+	}
+	assert.Equal(t, []int{1}, first)
+}
+
+func Test_Iter_Compact(t *testing.T) {
+	s := []Nullable[string]{Value("a"), Null[string](), Value("")}
+	assert.Equal(t, []string{"a", ""}, Compact(s))
+	assert.Equal(t, []string{}, Compact[string](nil))
+}
+
+func Test_Iter_FromSlice(t *testing.T) {
+	s := FromSlice([]int{1, 2})
+	assert.Equal(t, []Nullable[int]{Value(1), Value(2)}, s)
+	assert.Nil(t, FromSlice[int](nil))
+
+	errs := FromSlice([]error{nil})
+	assert.False(t, errs[0].Valid)
+}
+
+func Test_Iter_FromPointers(t *testing.T) {
+	one := 1
+	s := FromPointers([]*int{&one, nil})
+	assert.Equal(t, []Nullable[int]{Value(1), Null[int]()}, s)
+	assert.Nil(t, FromPointers[int](nil))
+}
+
+func Test_Iter_NullIndexes(t *testing.T) {
+	s := []Nullable[int]{Null[int](), Value(2), Null[int]()}
+	assert.Equal(t, []int{0, 2}, NullIndexes(s))
+	assert.Empty(t, NullIndexes([]Nullable[int]{Value(1)}))
+}
+
+func Test_Iter_Sort(t *testing.T) {
+	s := []Nullable[int]{Value(3), Null[int](), Value(1), Null[int](), Value(2)}
+
+	slices.SortFunc(s, NullsFirst(cmp.Compare[int]))
+	assert.Equal(t, []Nullable[int]{Null[int](), Null[int](), Value(1), Value(2), Value(3)}, s)
+
+	slices.SortFunc(s, NullsLast(cmp.Compare[int]))
+	assert.Equal(t, []Nullable[int]{Value(1), Value(2), Value(3), Null[int](), Null[int]()}, s)
+}
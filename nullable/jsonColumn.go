@@ -0,0 +1,138 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// JSONNullMode decides how a JSON null in a JSON column relates to SQL NULL
+type JSONNullMode int
+
+const (
+	// JSONNullIsSQLNull treats a JSON null the same as SQL NULL. Scanning a JSON null gives a NULL Nullable,
+	// and data that encodes to JSON null is written as SQL NULL
+	JSONNullIsSQLNull JSONNullMode = iota
+	// JSONNullIsValue keeps JSON null distinct from SQL NULL. Scanning a JSON null gives a valid zero value,
+	// and only a NULL Nullable is written as SQL NULL
+	JSONNullIsValue
+)
+
+var (
+	jsonColumnNulls sync.Map
+
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// RegisterJSONColumnNull Use mode for JSON null in the JSON column of Nullable[T], instead of JSONNullIsSQLNull.
+// The returned function restores the mode that was registered before, which is useful in tests
+func RegisterJSONColumnNull[T any](mode JSONNullMode) (restore func()) {
+	return registerCodec[T](&jsonColumnNulls, &mode)
+}
+
+// isJSONColumnType Check if t is stored as JSON in SQL. Structs, maps and slices without their
+// own scanner are stored as JSON, so they can be used with JSON and JSONB columns.
+// Fixed-size arrays are not, since they are more likely binary values like hashes,
+// and neither are types with a text form, like netip.Addr, which are stored as text
+func isJSONColumnType(t reflect.Type) bool {
+	return isStructuredType(t) && !isTextColumnType(t)
+}
+
+// isTextColumnType Check if t is a struct, map or slice that is stored as its text in SQL,
+// since it implements encoding.TextMarshaler or encoding.TextUnmarshaler, or is a url.URL
+func isTextColumnType(t reflect.Type) bool {
+	if !isStructuredType(t) {
+		return false
+	}
+	pointer := reflect.PointerTo(t)
+	return pointer.Implements(textMarshalerType) || pointer.Implements(textUnmarshalerType) || t == reflect.TypeOf(url.URL{})
+}
+
+// isStructuredType Check if t is a struct, map or slice without its own scanner, other than time.Time and byte slices
+func isStructuredType(t reflect.Type) bool {
+	if t == nil || t == reflect.TypeOf(time.Time{}) || isArrayType(t) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice:
+		return !(t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+	}
+	return false
+}
+
+func (n *Nullable[T]) scanJSONColumn(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		n.Valid = false
+		return fmt.Errorf("null: cannot scan %T into JSON column type %T", value, n.Data)
+	}
+
+	var ref T
+	n.Data = ref
+	if bytes.Equal(bytes.TrimSpace(data), nullBytes) {
+		n.Valid = planOf[T]().jsonColumnNull == JSONNullIsValue
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Data); err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: could not scan JSON column: %w", err)
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n Nullable[T]) jsonColumnValue() (driver.Value, error) {
+	data, err := json.Marshal(n.Data)
+	if err != nil {
+		return nil, fmt.Errorf("null: could not write JSON column: %w", err)
+	}
+	if planOf[T]().jsonColumnNull == JSONNullIsSQLNull && bytes.Equal(data, nullBytes) {
+		return nil, nil
+	}
+	return string(data), nil
+}
+
+// scanTextColumn Scan the text of a type with a text form, like netip.Addr or url.URL
+func (n *Nullable[T]) scanTextColumn(value any) error {
+	var text []byte
+	switch v := value.(type) {
+	case []byte:
+		text = v
+	case string:
+		text = []byte(v)
+	default:
+		n.Valid = false
+		return fmt.Errorf("null: cannot scan %T into text column type %T", value, n.Data)
+	}
+
+	var err error
+	if unmarshaler, ok := any(&n.Data).(encoding.TextUnmarshaler); ok {
+		err = unmarshaler.UnmarshalText(text)
+	} else if ok, stdErr := unmarshalTextStd(string(text), any(&n.Data)); ok {
+		err = stdErr
+	} else {
+		err = fmt.Errorf("null: type %T cannot be scanned from text", n.Data)
+	}
+	n.Valid = err == nil
+	return err
+}
+
+func (n Nullable[T]) textColumnValue() (driver.Value, error) {
+	text, err := n.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
+	"time"
 )
 
 var nullBytes = []byte("null")
@@ -13,18 +15,72 @@ func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(n.Data)
+	plan := planOf[T]()
+	if plan.jsonCodec != nil {
+		return plan.jsonCodec.encode(n.Data)
+	}
+	if data, ok, err := appendJSONBuiltIn(nil, any(&n.Data), floatFormatOf[T](), plan.nonFinite, plan.int64JSON); ok {
+		return data, err
+	}
+	if isJSONTextType(n.Data) {
+		text, err := n.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(text))
+	}
+	// A pointer, so the methods of T with pointer receivers are used
+	return json.Marshal(&n.Data)
 }
 
 func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	// A registered codec reads the JSON, before the names of an enum
+	if plan := planOf[T](); len(data) > 0 && data[0] == '"' && plan.enum != nil && plan.jsonCodec == nil {
+		var name string
+		if json.Unmarshal(data, &name) == nil {
+			if value, ok := lookupEnumName[T](name); ok {
+				n.Data, n.Valid = value, true
+				return nil
+			}
+		}
+	}
+	if err := n.unmarshalJSON(data); err != nil {
+		return err
+	}
+	if err := n.validateNonFinite(); err != nil {
+		return err
+	}
+	return n.validateEnum()
+}
+
+func (n *Nullable[T]) unmarshalJSON(data []byte) error {
 	if bytes.Equal(data, nullBytes) {
 		n.Valid = false
 		return nil
 	}
+	if codec := lookupJSONCodec[T](); codec != nil {
+		return n.decodeWith(func() (T, error) { return codec.decode(data) })
+	}
+
+	if unmarshalJSONBuiltIn(data, any(&n.Data)) {
+		n.Valid = true
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		// Numbers in strings are parsed directly, instead of first failing to unmarshal them as numbers
+		switch any(n.Data).(type) {
+		case float32, float64:
+			return unmarshalFloatStringJson(n, data)
+		case int, int8, int16, int32, int64:
+			return unmarshalIntStringJson(n, data)
+		}
+	}
 
-	err := json.Unmarshal(data, &n.Data)
+	// A copy, so the data is kept if decoding fails halfway, while structs are still decoded into the existing data
+	value := n.Data
+	err := json.Unmarshal(data, &value)
 	if err == nil {
-		n.Valid = true
+		n.Data, n.Valid = value, true
 		return nil
 	}
 
@@ -33,11 +89,133 @@ func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
 		return unmarshalFloatStringJson(n, data)
 	case int, int8, int16, int32, int64:
 		return unmarshalIntStringJson(n, data)
+	case time.Duration, url.URL, *url.URL, *time.Location, complex64, complex128:
+		return unmarshalTextStringJson(n, data)
 	}
 
 	return fmt.Errorf("null: could not unmarshal JSON: %w", err)
 }
 
+// unmarshalJSONBuiltIn Decode a plain JSON literal into dest, a pointer to a built-in type, without reflection.
+// dest is only set if the literal is valid. Returns false if dest is another type or the literal needs encoding/json,
+// like strings with escapes, which then also gives the error for invalid input
+func unmarshalJSONBuiltIn(data []byte, dest any) bool {
+	switch d := dest.(type) {
+	case *string:
+		if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+			return false
+		}
+		body := data[1 : len(data)-1]
+		for _, c := range body {
+			if c == '\\' || c == '"' || c < 0x20 || c >= 0x80 {
+				return false
+			}
+		}
+		*d = string(body)
+	case *bool:
+		switch string(data) {
+		case "true":
+			*d = true
+		case "false":
+			*d = false
+		default:
+			return false
+		}
+	case *int:
+		i, ok := parseJSONInt(data, strconv.IntSize)
+		if !ok {
+			return false
+		}
+		*d = int(i)
+	case *int8:
+		i, ok := parseJSONInt(data, 8)
+		if !ok {
+			return false
+		}
+		*d = int8(i)
+	case *int16:
+		i, ok := parseJSONInt(data, 16)
+		if !ok {
+			return false
+		}
+		*d = int16(i)
+	case *int32:
+		i, ok := parseJSONInt(data, 32)
+		if !ok {
+			return false
+		}
+		*d = int32(i)
+	case *int64:
+		i, ok := parseJSONInt(data, 64)
+		if !ok {
+			return false
+		}
+		*d = i
+	case *float64:
+		if !isJSONNumber(string(data)) {
+			return false
+		}
+		f, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return false
+		}
+		*d = f
+	case *float32:
+		if !isJSONNumber(string(data)) {
+			return false
+		}
+		f, err := strconv.ParseFloat(string(data), 32)
+		if err != nil {
+			return false
+		}
+		*d = float32(f)
+	default:
+		return false
+	}
+	return true
+}
+
+// parseJSONInt Parse a JSON integer literal that fits in bits
+func parseJSONInt(data []byte, bits int) (int64, bool) {
+	digits := data
+	negative := len(digits) > 0 && digits[0] == '-'
+	if negative {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 || len(digits) > 19 || (digits[0] == '0' && len(digits) > 1) {
+		return 0, false
+	}
+
+	var value uint64
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		value = value*10 + uint64(c-'0')
+	}
+
+	limit := uint64(1) << (bits - 1)
+	if negative {
+		if value > limit {
+			return 0, false
+		}
+		return -int64(value), true
+	}
+	if value >= limit {
+		return 0, false
+	}
+	return int64(value), true
+}
+
+// unmarshalTextStringJson Unmarshal a JSON string with the text of a type that encoding/json cannot read
+func unmarshalTextStringJson[T any](f *Nullable[T], data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: could not unmarshal JSON: %w", err)
+	}
+	return f.unmarshalText([]byte(str))
+}
+
 func unmarshalFloatStringJson[T any](f *Nullable[T], data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
@@ -82,15 +260,17 @@ func unmarshalIntStringJson[T any](f *Nullable[T], data []byte) error {
 		size = 8
 	case int16:
 		size = 16
-	case int32, int:
+	case int32:
 		size = 32
+	case int:
+		size = strconv.IntSize
 	case int64:
 		size = 64
 	}
 
 	n, err := strconv.ParseInt(str, 10, size)
 	if err != nil {
-		return fmt.Errorf("null: couldn't convert string to float: %w", err)
+		return fmt.Errorf("null: couldn't convert string to int: %w", err)
 	}
 
 	switch v.(type) {
@@ -0,0 +1,151 @@
+package nullable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"net/url"
+	"testing"
+)
+
+func Test_Json_column_scan_struct(t *testing.T) {
+	var addr Nullable[address]
+	err := addr.Scan([]byte(`{"addressLine":"RoadStreet 1A","postNumber":"1234","county":null}`))
+	assert.NoError(t, err)
+	assert.True(t, addr.Valid)
+	assert.Equal(t, "RoadStreet 1A", addr.Data.AddressLine1)
+	assert.False(t, addr.Data.County.Valid)
+
+	err = addr.Scan(`{"addressLine":"RoadStreet 1B"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "RoadStreet 1B", addr.Data.AddressLine1)
+	assert.Equal(t, "", addr.Data.PostNumber)
+
+	err = addr.Scan(nil)
+	assert.NoError(t, err)
+	assert.False(t, addr.Valid)
+
+	err = addr.Scan(`{"addressLine":`)
+	assert.Error(t, err)
+	assert.False(t, addr.Valid)
+
+	err = addr.Scan(int64(5))
+	assert.Error(t, err)
+	assert.False(t, addr.Valid)
+}
+
+func Test_Json_column_scan_map_and_slice(t *testing.T) {
+	var m Nullable[map[string]int]
+	assert.NoError(t, m.Scan(`{"a":1}`))
+	assert.Equal(t, Value(map[string]int{"a": 1}), m)
+
+	var s Nullable[[]string]
+	assert.NoError(t, s.Scan(`["a","b"]`))
+	assert.Equal(t, Value([]string{"a", "b"}), s)
+}
+
+func Test_Json_column_value(t *testing.T) {
+	addr := Value(address{AddressLine1: "RoadStreet 1A", County: Value("Texas")})
+	value, err := addr.Value()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"addressLine":"RoadStreet 1A","addressLine2":null,"postNumber":"","city":"","county":"Texas"}`, value.(string))
+
+	value, err = Null[address]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = Value(map[string]int{"a": 1}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, value)
+}
+
+func Test_Json_column_null_is_sql_null(t *testing.T) {
+	m := Value(map[string]int{"a": 1})
+	assert.NoError(t, m.Scan("null"))
+	assert.False(t, m.Valid)
+
+	value, err := Value[map[string]int](nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test_Json_column_null_is_value(t *testing.T) {
+	restore := RegisterJSONColumnNull[map[string]int](JSONNullIsValue)
+	defer restore()
+
+	m := Value(map[string]int{"a": 1})
+	assert.NoError(t, m.Scan(" null "))
+	assert.True(t, m.Valid)
+	assert.Nil(t, m.Data)
+
+	value, err := m.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "null", value)
+
+	value, err = Null[map[string]int]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test_Json_column_null_per_type(t *testing.T) {
+	restore := RegisterJSONColumnNull[map[string]int](JSONNullIsValue)
+	defer restore()
+
+	// Other types keep JSON null as SQL NULL
+	s := Value([]string{"a"})
+	assert.NoError(t, s.Scan("null"))
+	assert.False(t, s.Valid)
+
+	m := Null[map[string]int]()
+	assert.NoError(t, m.Scan("null"))
+	assert.True(t, m.Valid)
+
+	restore()
+	assert.NoError(t, m.Scan("null"))
+	assert.False(t, m.Valid)
+}
+
+func Test_Json_column_fixed_array(t *testing.T) {
+	// Fixed-size arrays are not written as JSON
+	value, err := Value([2]int{1, 2}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, [2]int{1, 2}, value)
+
+	var hash Nullable[[4]byte]
+	assert.Error(t, hash.Scan(`[1,2,3,4]`))
+}
+
+func Test_Text_column_netip(t *testing.T) {
+	value, err := Value(netip.MustParseAddr("127.0.0.1")).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", value)
+
+	var addr Nullable[netip.Addr]
+	assert.NoError(t, addr.Scan("::1"))
+	assert.Equal(t, Value(netip.MustParseAddr("::1")), addr)
+	assert.NoError(t, addr.Scan([]byte("10.0.0.0")))
+	assert.Equal(t, Value(netip.MustParseAddr("10.0.0.0")), addr)
+	assert.Error(t, addr.Scan("not an address"))
+	assert.False(t, addr.Valid)
+	assert.Error(t, addr.Scan(int64(1)))
+
+	var prefix Nullable[netip.Prefix]
+	assert.NoError(t, prefix.Scan("10.0.0.0/8"))
+	value, err = prefix.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", value)
+}
+
+func Test_Text_column_url(t *testing.T) {
+	u, err := url.Parse("https://example.com/a?b=c")
+	assert.NoError(t, err)
+
+	value, err := Value(*u).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/a?b=c", value)
+
+	var scanned Nullable[url.URL]
+	assert.NoError(t, scanned.Scan("https://example.com/a?b=c"))
+	assert.Equal(t, Value(*u), scanned)
+	assert.Error(t, scanned.Scan("%zz"))
+	assert.False(t, scanned.Valid)
+}
@@ -0,0 +1,46 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+// encoding/json/v2 is only built with the jsonv2 experiment, which is enabled by default since Go 1.27.
+// It can be disabled with GOEXPERIMENT=nojsonv2, which leaves out this file
+
+package nullable
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// MarshalJSONTo Implement json.MarshalerTo of encoding/json/v2, writing the same JSON as MarshalJSON.
+// Primitive types are appended directly to the buffer of the encoder.
+// Numbers are written as strings with the json.StringifyNumbers option, like v2 does for plain numbers
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	data, err := n.AppendJSON(enc.AvailableBuffer())
+	if err != nil {
+		return err
+	}
+	if stringify, _ := jsonv2.GetOption(enc.Options(), jsonv2.StringifyNumbers); stringify && jsontext.Value(data).Kind() == '0' {
+		data = append(append([]byte{'"'}, data...), '"')
+	}
+	return enc.WriteValue(data)
+}
+
+// UnmarshalJSONFrom Implement json.UnmarshalerFrom of encoding/json/v2, reading JSON like UnmarshalJSON,
+// including numbers in strings
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.Valid = false
+		return nil
+	}
+
+	value, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return n.UnmarshalJSON(value)
+}
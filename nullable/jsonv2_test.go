@@ -0,0 +1,140 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package nullable
+
+import (
+	"encoding/json"
+	jsonv2 "encoding/json/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type engineRecord struct {
+	Int     Nullable[int]            `json:"int"`
+	Float   Nullable[float64]        `json:"float"`
+	Float32 Nullable[float32]        `json:"float32"`
+	String  Nullable[string]         `json:"string"`
+	Bool    Nullable[bool]           `json:"bool"`
+	Time    Nullable[time.Time]      `json:"time"`
+	Decimal Nullable[Decimal]        `json:"decimal"`
+	Tags    Nullable[[]string]       `json:"tags"`
+	Labels  Nullable[map[string]int] `json:"labels"`
+}
+
+// engines are the JSON implementations every fixture is run with
+var engines = map[string]struct {
+	marshal   func(any) ([]byte, error)
+	unmarshal func([]byte, any) error
+}{
+	"v1": {json.Marshal, json.Unmarshal},
+	"v2": {
+		func(v any) ([]byte, error) { return jsonv2.Marshal(v) },
+		func(data []byte, v any) error { return jsonv2.Unmarshal(data, v) },
+	},
+}
+
+var engineFixtures = []struct {
+	name     string
+	json     string
+	expected engineRecord
+}{
+	{
+		name: "values",
+		json: `{"int":-42,"float":1.5,"float32":0.25,"string":"a <b> & \"c\"","bool":true,` +
+			`"time":"2024-02-29T13:14:15.123+01:00","decimal":12.50,"tags":["x","y"],"labels":{"a":1}}`,
+		expected: engineRecord{
+			Int:     Value(-42),
+			Float:   Value(1.5),
+			Float32: Value(float32(0.25)),
+			String:  Value(`a <b> & "c"`),
+			Bool:    Value(true),
+			Time:    Value(time.Date(2024, 2, 29, 13, 14, 15, 123000000, time.FixedZone("", 3600))),
+			Decimal: Value(NewDecimal(1250, 2)),
+			Tags:    Value([]string{"x", "y"}),
+			Labels:  Value(map[string]int{"a": 1}),
+		},
+	},
+	{
+		name:     "nulls",
+		json:     `{"int":null,"float":null,"float32":null,"string":null,"bool":null,"time":null,"decimal":null,"tags":null,"labels":null}`,
+		expected: engineRecord{},
+	},
+	{
+		name:     "numbers in strings",
+		json:     `{"int":"7","float":"-0.5","float32":"1e3","decimal":"0.10"}`,
+		expected: engineRecord{Int: Value(7), Float: Value(-0.5), Float32: Value(float32(1000)), Decimal: Value(NewDecimal(10, 2))},
+	},
+	{
+		name:     "missing",
+		json:     `{}`,
+		expected: engineRecord{},
+	},
+}
+
+func Test_JSONEngines_unmarshal(t *testing.T) {
+	for engineName, engine := range engines {
+		for _, fixture := range engineFixtures {
+			var record engineRecord
+			assert.NoError(t, engine.unmarshal([]byte(fixture.json), &record), engineName+" "+fixture.name)
+			assert.Equal(t, fixture.expected.Time.Valid, record.Time.Valid, engineName+" "+fixture.name)
+			assert.True(t, fixture.expected.Time.Equal(record.Time), engineName+" "+fixture.name)
+			record.Time = fixture.expected.Time
+			assert.Equal(t, fixture.expected, record, engineName+" "+fixture.name)
+		}
+	}
+}
+
+func Test_JSONEngines_unmarshal_errors(t *testing.T) {
+	for engineName, engine := range engines {
+		for _, data := range []string{`{"int":"abc"}`, `{"int":1.5}`, `{"bool":"yes"}`, `{"float":"x"}`, `{"decimal":true}`} {
+			var record engineRecord
+			assert.Error(t, engine.unmarshal([]byte(data), &record), engineName+" "+data)
+		}
+	}
+}
+
+func Test_JSONEngines_marshal(t *testing.T) {
+	for _, fixture := range engineFixtures[:2] {
+		expected, err := json.Marshal(fixture.expected)
+		assert.NoError(t, err)
+
+		for engineName, engine := range engines {
+			data, err := engine.marshal(fixture.expected)
+			assert.NoError(t, err, engineName)
+			// v2 does not escape HTML characters, so only the values are compared
+			assert.JSONEq(t, string(expected), string(data), engineName+" "+fixture.name)
+		}
+	}
+}
+
+func Test_JSONEngines_roundTrip(t *testing.T) {
+	for engineName, engine := range engines {
+		data, err := engine.marshal(engineFixtures[0].expected)
+		assert.NoError(t, err)
+
+		var record engineRecord
+		assert.NoError(t, engine.unmarshal(data, &record), engineName)
+		assert.True(t, engineFixtures[0].expected.Time.Equal(record.Time))
+		record.Time = engineFixtures[0].expected.Time
+		assert.Equal(t, engineFixtures[0].expected, record, engineName)
+	}
+}
+
+func Test_JSONEngines_stringifyNumbers(t *testing.T) {
+	record := struct {
+		Id    Nullable[int64]   `json:"id"`
+		Float Nullable[float64] `json:"float"`
+		Name  Nullable[string]  `json:"name"`
+		Null  Nullable[int64]   `json:"null"`
+	}{Id: Value(int64(9007199254740993)), Float: Value(1.5), Name: Value("a")}
+
+	data, err := jsonv2.Marshal(record, jsonv2.StringifyNumbers(true))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"9007199254740993","float":"1.5","name":"a","null":null}`, string(data))
+
+	record.Id, record.Float = Null[int64](), Null[float64]()
+	assert.NoError(t, jsonv2.Unmarshal(data, &record, jsonv2.StringifyNumbers(true)))
+	assert.Equal(t, Value(int64(9007199254740993)), record.Id)
+	assert.Equal(t, Value(1.5), record.Float)
+}
@@ -0,0 +1,107 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Layout gives the time layout of a LayoutTime. It may also implement Location() *time.Location
+// to parse and format times in that location instead of the Location of the TimeOptions of the LayoutTime
+type Layout interface {
+	Layout() string
+}
+
+// DateTimeLayout is the layout 2006-01-02 15:04:05, as used by MySQL and SQLite
+type DateTimeLayout struct{}
+
+func (DateTimeLayout) Layout() string {
+	return time.DateTime
+}
+
+// RFC1123Layout is the layout of HTTP dates, like Mon, 02 Jan 2006 15:04:05 MST
+type RFC1123Layout struct{}
+
+func (RFC1123Layout) Layout() string {
+	return time.RFC1123
+}
+
+// LayoutTime is a time.Time written with the layout given by L in JSON, text and SQL scanning
+type LayoutTime[L Layout] struct {
+	time.Time
+}
+
+// ParseLayoutTime Parse a time with the layout given by L
+func ParseLayoutTime[L Layout](value string) (LayoutTime[L], error) {
+	var layout L
+	t, err := time.ParseInLocation(layout.Layout(), value, layoutLocation[L]())
+	if err != nil {
+		return LayoutTime[L]{}, fmt.Errorf("null: could not parse time: %w", err)
+	}
+	return LayoutTime[L]{Time: t}, nil
+}
+
+// String Format the time with the layout given by L
+func (t LayoutTime[L]) String() string {
+	var layout L
+	return t.In(layoutLocation[L]()).Format(layout.Layout())
+}
+
+func (t LayoutTime[L]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *LayoutTime[L]) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: could not unmarshal time: %w", err)
+	}
+	return t.UnmarshalText([]byte(str))
+}
+
+func (t LayoutTime[L]) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText Implement encoding.TextAppender, instead of the method of the embedded time.Time
+func (t LayoutTime[L]) AppendText(b []byte) ([]byte, error) {
+	var layout L
+	return t.In(layoutLocation[L]()).AppendFormat(b, layout.Layout()), nil
+}
+
+func (t *LayoutTime[L]) UnmarshalText(text []byte) error {
+	parsed, err := ParseLayoutTime[L](string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading a time.Time or a string with the layout given by L
+func (t *LayoutTime[L]) Scan(src any) error {
+	switch s := src.(type) {
+	case time.Time:
+		t.Time = s
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(s))
+	case []byte:
+		return t.UnmarshalText(s)
+	}
+	return fmt.Errorf("null: cannot scan %T into LayoutTime", src)
+}
+
+// Value Implement driver.Valuer, writing the time.Time
+func (t LayoutTime[L]) Value() (driver.Value, error) {
+	return t.Time, nil
+}
+
+func layoutLocation[L Layout]() *time.Location {
+	var layout L
+	if located, ok := any(layout).(interface{ Location() *time.Location }); ok {
+		return located.Location()
+	}
+	return timeOptionsOf[LayoutTime[L]]().location()
+}
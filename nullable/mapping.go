@@ -0,0 +1,160 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Mapping maps the values of T to and from their representations in a database or in text, like 'Y' and 'N'
+// for booleans or short codes for string enums. A Mapping registered with RegisterMapping is used by
+// Scan, Value, MarshalText and UnmarshalText of every Nullable[T]. For only some fields, use Mapped instead.
+// A Mapping is read without locking, so it must not be changed after it has been registered or used
+type Mapping[T comparable] struct {
+	encode map[T]driver.Value
+	decode map[string]T
+}
+
+// valueMapping is a Mapping with the type parameter removed, so it can be kept in the registry
+type valueMapping interface {
+	scan(src any) (any, error)
+	value(data any) (driver.Value, error)
+	text(data any) ([]byte, error)
+}
+
+// mappings holds the registered mappings by reflect.Type
+var mappings sync.Map
+
+// NewMapping Create an empty Mapping
+func NewMapping[T comparable]() *Mapping[T] {
+	return &Mapping[T]{encode: map[T]driver.Value{}, decode: map[string]T{}}
+}
+
+// NewBoolMapping Create a Mapping for booleans written as trueValue and falseValue, which also reads the common
+// legacy representations 1/0, t/f, y/n, true/false and yes/no
+func NewBoolMapping(trueValue, falseValue driver.Value) *Mapping[bool] {
+	return NewMapping[bool]().
+		Map(true, trueValue, int64(1), "t", "true", "y", "yes").
+		Map(false, falseValue, int64(0), "f", "false", "n", "no")
+}
+
+// Map Add value with its representation, which is written by Value and MarshalText.
+// The representation and the aliases are read by Scan and UnmarshalText, ignoring case and surrounding spaces
+func (m *Mapping[T]) Map(value T, representation driver.Value, aliases ...driver.Value) *Mapping[T] {
+	m.encode[value] = representation
+	for _, alias := range append([]driver.Value{representation}, aliases...) {
+		m.decode[mappingKey(alias)] = value
+	}
+	return m
+}
+
+// RegisterMapping Use m for all Nullable[T] in the process, replacing any earlier Mapping for T.
+// Prefer a named type for T, or Mapped, since a Mapping for a type like bool also applies to real boolean columns.
+// m must not be changed after it has been registered.
+// The returned function restores the Mapping that was registered before, which is useful in tests
+func RegisterMapping[T comparable](m *Mapping[T]) (restore func()) {
+	return registerCodec[T](&mappings, valueMapping(m))
+}
+
+// MappingOf gives the Mapping of a Mapped field, like Layout gives the layout of a LayoutTime
+type MappingOf[T comparable] interface {
+	Mapping() *Mapping[T]
+}
+
+// Mapped is a T read and written with the Mapping given by M in Scan, Value, MarshalText and UnmarshalText,
+// for a field that uses a Mapping when other fields of type T do not, like Nullable[Mapped[bool, YesNo]].
+// JSON is the JSON of T, and NULL is handled by Nullable
+type Mapped[T comparable, M MappingOf[T]] struct {
+	Data T
+}
+
+// Scan Implement sql.Scanner, reading a representation of the Mapping
+func (m *Mapped[T, M]) Scan(src any) error {
+	var provider M
+	value, err := provider.Mapping().scan(src)
+	if err != nil {
+		return err
+	}
+	m.Data = value.(T)
+	return nil
+}
+
+// Value Implement driver.Valuer, writing the representation of the data
+func (m Mapped[T, M]) Value() (driver.Value, error) {
+	var provider M
+	return provider.Mapping().value(m.Data)
+}
+
+func (m Mapped[T, M]) MarshalText() ([]byte, error) {
+	var provider M
+	return provider.Mapping().text(m.Data)
+}
+
+func (m *Mapped[T, M]) UnmarshalText(text []byte) error {
+	return m.Scan(string(text))
+}
+
+func (m Mapped[T, M]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Data)
+}
+
+func (m *Mapped[T, M]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &m.Data)
+}
+
+func lookupMapping[T any]() valueMapping {
+	return planOf[T]().mapping
+}
+
+func (m *Mapping[T]) scan(src any) (any, error) {
+	value, ok := m.decode[mappingKey(src)]
+	if !ok {
+		var ref T
+		return nil, fmt.Errorf("null: no mapping for %v to %T", src, ref)
+	}
+	return value, nil
+}
+
+func (m *Mapping[T]) value(data any) (driver.Value, error) {
+	representation, ok := m.encode[data.(T)]
+	if !ok {
+		return nil, fmt.Errorf("null: no mapping for %T value %v", data, data)
+	}
+	return representation, nil
+}
+
+func (m *Mapping[T]) text(data any) ([]byte, error) {
+	representation, err := m.value(data)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := representation.([]byte); ok {
+		return b, nil
+	}
+	return []byte(fmt.Sprint(representation)), nil
+}
+
+// mappingKey Get the lookup key of a representation, so different types with the same text, like 1 and "1", match
+func mappingKey(representation any) string {
+	var str string
+	switch r := representation.(type) {
+	case string:
+		str = r
+	case []byte:
+		str = string(r)
+	default:
+		value := reflect.ValueOf(r)
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			str = fmt.Sprint(value.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			str = fmt.Sprint(value.Uint())
+		default:
+			str = fmt.Sprint(r)
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(str))
+}
@@ -0,0 +1,161 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type legacyStatus string
+
+const (
+	statusActive   legacyStatus = "active"
+	statusInactive legacyStatus = "inactive"
+)
+
+func Test_Mapping_bool(t *testing.T) {
+	defer RegisterMapping(NewBoolMapping("Y", "N"))()
+
+	for _, value := range []any{"Y", "y", []byte("Y "), int64(1), "yes", "T", "true", true} {
+		var b Nullable[bool]
+		assert.NoError(t, b.Scan(value), value)
+		assertBool(t, b, "mapped bool")
+	}
+	for _, value := range []any{"N", int64(0), "no", "F", false} {
+		b := Value(true)
+		assert.NoError(t, b.Scan(value), value)
+		assertFalseBool(t, b, "mapped bool")
+	}
+
+	var invalid Nullable[bool]
+	assert.Error(t, invalid.Scan("maybe"))
+	assert.False(t, invalid.Valid)
+
+	var null Nullable[bool]
+	assert.NoError(t, null.Scan(nil))
+	assert.False(t, null.Valid)
+
+	value, err := Value(true).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "Y", value)
+	value, err = Value(false).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "N", value)
+	value, err = Null[bool]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	txt, err := Value(false).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "N", string(txt))
+
+	var fromText Nullable[bool]
+	assert.NoError(t, fromText.UnmarshalText([]byte("yes")))
+	assertBool(t, fromText, "mapped text")
+	assert.Error(t, fromText.UnmarshalText([]byte("maybe")))
+	assert.False(t, fromText.Valid)
+}
+
+func Test_Mapping_bool_smallint(t *testing.T) {
+	defer RegisterMapping(NewBoolMapping(int64(1), int64(0)))()
+
+	value, err := Value(true).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), value)
+
+	txt, err := Value(false).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "0", string(txt))
+
+	var b Nullable[bool]
+	assert.NoError(t, b.Scan(int64(1)))
+	assertBool(t, b, "smallint")
+}
+
+func Test_Mapping_unregistered(t *testing.T) {
+	var b Nullable[bool]
+	assert.Error(t, b.Scan("Y"))
+
+	txt, err := Value(true).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "true", string(txt))
+}
+
+func Test_Mapping_string_enum(t *testing.T) {
+	defer RegisterMapping(NewMapping[legacyStatus]().
+		Map(statusActive, "A", "act").
+		Map(statusInactive, "I"))()
+
+	var status Nullable[legacyStatus]
+	assert.NoError(t, status.Scan([]byte("A")))
+	assert.Equal(t, Value(statusActive), status)
+
+	assert.NoError(t, status.UnmarshalText([]byte("ACT")))
+	assert.Equal(t, Value(statusActive), status)
+
+	value, err := Value(statusInactive).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "I", value)
+
+	txt, err := Value(statusInactive).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "I", string(txt))
+
+	_, err = Value(legacyStatus("deleted")).Value()
+	assert.Error(t, err)
+}
+
+var yesNoMapping = NewBoolMapping("Y", "N")
+
+type yesNo struct{}
+
+func (yesNo) Mapping() *Mapping[bool] {
+	return yesNoMapping
+}
+
+type mappedRow struct {
+	Active   Nullable[Mapped[bool, yesNo]] `db:"active"`
+	Verified Nullable[bool]                `db:"verified"`
+}
+
+func Test_Mapping_per_field(t *testing.T) {
+	rows := queryTestRows(t, []string{"active", "verified"},
+		[]driver.Value{"Y", true},
+		[]driver.Value{"n", nil},
+		[]driver.Value{nil, false},
+	)
+	result, err := ScanAll[mappedRow](rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []mappedRow{
+		{Active: Value(Mapped[bool, yesNo]{true}), Verified: Value(true)},
+		{Active: Value(Mapped[bool, yesNo]{false}), Verified: Null[bool]()},
+		{Active: Null[Mapped[bool, yesNo]](), Verified: Value(false)},
+	}, result)
+
+	// A real boolean column is not read with the mapping
+	var verified Nullable[bool]
+	assert.Error(t, verified.Scan("Y"))
+
+	value, err := Value(Mapped[bool, yesNo]{true}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "Y", value)
+
+	txt, err := Value(Mapped[bool, yesNo]{false}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "N", string(txt))
+
+	var fromText Nullable[Mapped[bool, yesNo]]
+	assert.NoError(t, fromText.UnmarshalText([]byte("yes")))
+	assert.Equal(t, Value(Mapped[bool, yesNo]{true}), fromText)
+	assert.Error(t, fromText.UnmarshalText([]byte("maybe")))
+	assert.False(t, fromText.Valid)
+
+	data, err := json.Marshal(result[0])
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Active":true,"Verified":true}`, string(data))
+
+	var decoded mappedRow
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, result[0], decoded)
+}
@@ -0,0 +1,88 @@
+package nullable
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// NonFinitePolicy decides how Nullable[float32] and Nullable[float64] write and read NaN, +Inf and -Inf,
+// which JSON cannot represent as numbers
+type NonFinitePolicy int
+
+const (
+	// NonFiniteAsFloat fails to write non-finite values to JSON, like encoding/json. Everywhere else they are kept:
+	// MarshalText writes them like strconv.FormatFloat, Value writes the float, and the decode paths read them
+	NonFiniteAsFloat NonFinitePolicy = iota
+	// NonFiniteAsError fails to write and read non-finite values in JSON, text and SQL
+	NonFiniteAsError
+	// NonFiniteAsNull writes non-finite values as NULL, and reads them as NULL
+	NonFiniteAsNull
+	// NonFiniteAsString writes non-finite values as the strings "NaN", "Infinity" and "-Infinity" in JSON and text,
+	// which are quoted in JSON, and as the float in Value. They are read back from those strings and
+	// the spellings accepted by strconv.ParseFloat
+	NonFiniteAsString
+)
+
+var nonFinitePolicies sync.Map
+
+// RegisterNonFinitePolicy Use policy for NaN and infinite values of Nullable[T], instead of NonFiniteAsFloat.
+// The returned function restores the policy that was registered before, which is useful in tests
+func RegisterNonFinitePolicy[T float32 | float64](policy NonFinitePolicy) (restore func()) {
+	return registerCodec[T](&nonFinitePolicies, &policy)
+}
+
+// NonFiniteError is returned for NaN and infinite floats with the NonFiniteAsError policy.
+// MarshalJSON returns a json.UnsupportedValueError instead, like encoding/json
+type NonFiniteError struct {
+	Value float64
+}
+
+func (e *NonFiniteError) Error() string {
+	return fmt.Sprintf("null: %s is not a finite number", nonFiniteText(e.Value))
+}
+
+// nonFiniteFloat Get the value of data, a pointer to a float, and whether it is NaN or infinite
+func nonFiniteFloat(data any) (float64, bool) {
+	var f float64
+	switch d := data.(type) {
+	case *float64:
+		f = *d
+	case *float32:
+		f = float64(*d)
+	default:
+		return 0, false
+	}
+	return f, math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+func nonFiniteText(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case f > 0:
+		return "Infinity"
+	}
+	return "-Infinity"
+}
+
+// validateNonFinite Apply the policy to decoded data: NaN and infinite floats are set to NULL with NonFiniteAsNull
+// and NonFiniteAsError, which also returns an error. They are kept otherwise
+func (n *Nullable[T]) validateNonFinite() error {
+	if !n.Valid {
+		return nil
+	}
+	f, ok := nonFiniteFloat(&n.Data)
+	if !ok {
+		return nil
+	}
+	switch planOf[T]().nonFinite {
+	case NonFiniteAsNull:
+		var zero T
+		n.Data, n.Valid = zero, false
+	case NonFiniteAsError:
+		n.Valid = false
+		return &NonFiniteError{Value: f}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type measurement struct {
+	Sensor string            `json:"sensor"`
+	Value  Nullable[float64] `json:"value"`
+	Min    Nullable[float32] `json:"min"`
+}
+
+func Test_NonFinite_float(t *testing.T) {
+	_, err := json.Marshal(measurement{Value: Value(math.NaN())})
+	assert.Error(t, err)
+	_, err = Value(float32(math.Inf(-1))).AppendJSON(nil)
+	assert.Error(t, err)
+
+	// Only JSON fails, text and SQL keep the values
+	text, err := Value(math.Inf(1)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "+Inf", string(text))
+	text, err = Value(float32(math.Inf(-1))).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "-Inf", string(text))
+
+	value, err := Value(math.Inf(1)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, math.Inf(1), value)
+
+	var f Nullable[float64]
+	assert.NoError(t, f.UnmarshalText([]byte("NaN")))
+	assert.True(t, f.Valid)
+	assert.True(t, math.IsNaN(f.Data))
+	assert.NoError(t, f.UnmarshalJSON([]byte(`"Infinity"`)))
+	assert.Equal(t, Value(math.Inf(1)), f)
+	assert.NoError(t, f.Scan(math.Inf(-1)))
+	assert.Equal(t, Value(math.Inf(-1)), f)
+	assert.NoError(t, f.Scan("-Inf"))
+	assert.Equal(t, Value(math.Inf(-1)), f)
+}
+
+func Test_NonFinite_error(t *testing.T) {
+	defer RegisterNonFinitePolicy[float64](NonFiniteAsError)()
+	defer RegisterNonFinitePolicy[float32](NonFiniteAsError)()
+
+	_, err := json.Marshal(measurement{Value: Value(math.NaN())})
+	assert.Error(t, err)
+
+	_, err = Value(math.Inf(1)).MarshalText()
+	assert.EqualError(t, err, "null: Infinity is not a finite number")
+	_, err = Value(float32(math.Inf(-1))).AppendText(nil)
+	assert.Equal(t, &NonFiniteError{Value: math.Inf(-1)}, err)
+	_, err = Value(math.NaN()).Value()
+	assert.EqualError(t, err, "null: NaN is not a finite number")
+
+	// Finite values are not affected
+	text, err := Value(float32(1.5)).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5", string(text))
+
+	f := Value(1.0)
+	assert.EqualError(t, f.UnmarshalText([]byte("NaN")), "null: NaN is not a finite number")
+	assert.False(t, f.Valid)
+
+	f = Value(1.0)
+	assert.EqualError(t, f.UnmarshalJSON([]byte(`"-Infinity"`)), "null: -Infinity is not a finite number")
+	assert.False(t, f.Valid)
+
+	f = Value(1.0)
+	assert.EqualError(t, f.Scan(math.Inf(1)), "null: Infinity is not a finite number")
+	assert.False(t, f.Valid)
+
+	var g Nullable[float32]
+	assert.Error(t, g.Scan("-Inf"))
+	assert.False(t, g.Valid)
+
+	assert.NoError(t, f.Scan(2.5))
+	assert.Equal(t, Value(2.5), f)
+}
+
+func Test_NonFinite_null(t *testing.T) {
+	defer RegisterNonFinitePolicy[float64](NonFiniteAsNull)()
+	defer RegisterNonFinitePolicy[float32](NonFiniteAsNull)()
+
+	data, err := json.Marshal(measurement{Sensor: "a", Value: Value(math.NaN()), Min: Value(float32(math.Inf(-1)))})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"sensor":"a","value":null,"min":null}`, string(data))
+
+	text, err := Value(math.Inf(1)).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+
+	value, err := Value(math.NaN()).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	f := Value(1.0)
+	assert.NoError(t, f.UnmarshalText([]byte("NaN")))
+	assert.Equal(t, Null[float64](), f)
+
+	f = Value(1.0)
+	assert.NoError(t, f.UnmarshalJSON([]byte(`"-Infinity"`)))
+	assert.Equal(t, Null[float64](), f)
+
+	f = Value(1.0)
+	assert.NoError(t, f.Scan(math.Inf(1)))
+	assert.Equal(t, Null[float64](), f)
+}
+
+func Test_NonFinite_string(t *testing.T) {
+	defer RegisterNonFinitePolicy[float64](NonFiniteAsString)()
+	defer RegisterNonFinitePolicy[float32](NonFiniteAsString)()
+
+	m := measurement{Sensor: "a", Value: Value(math.Inf(1)), Min: Value(float32(math.Inf(-1)))}
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"sensor":"a","value":"Infinity","min":"-Infinity"}`, string(data))
+
+	var decoded measurement
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, m, decoded)
+
+	data, err = Value(math.NaN()).AppendJSON(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"NaN"`, string(data))
+
+	var f Nullable[float64]
+	assert.NoError(t, f.UnmarshalJSON(data))
+	assert.True(t, f.Valid)
+	assert.True(t, math.IsNaN(f.Data))
+
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		text, err := Value(value).MarshalText()
+		assert.NoError(t, err)
+
+		var parsed Nullable[float64]
+		assert.NoError(t, parsed.UnmarshalText(text), string(text))
+		assert.True(t, parsed.Valid)
+		assert.Equal(t, math.IsNaN(value), math.IsNaN(parsed.Data))
+		if !math.IsNaN(value) {
+			assert.Equal(t, value, parsed.Data)
+		}
+
+		sqlValue, err := Value(value).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, math.IsNaN(value), math.IsNaN(sqlValue.(float64)))
+		if !math.IsNaN(value) {
+			assert.Equal(t, value, sqlValue)
+		}
+
+		assert.NoError(t, parsed.Scan(sqlValue))
+		assert.True(t, parsed.Valid)
+		assert.Equal(t, math.IsNaN(value), math.IsNaN(parsed.Data))
+	}
+
+	// Other spellings of strconv.ParseFloat are read too
+	assert.NoError(t, f.UnmarshalText([]byte("-inf")))
+	assert.Equal(t, Value(math.Inf(-1)), f)
+}
+
+func Test_NonFinite_per_type(t *testing.T) {
+	defer RegisterNonFinitePolicy[float32](NonFiniteAsNull)()
+
+	data, err := json.Marshal(Value(float32(math.NaN())))
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	_, err = json.Marshal(Value(math.NaN()))
+	assert.Error(t, err)
+}
@@ -3,89 +3,134 @@ package nullable
 import (
 	"database/sql"
 	"fmt"
+	"reflect"
 	"time"
 )
 
-func (n *Nullable[T]) getScanner() sql.Scanner {
-	dest := any(&n.Data)
-	switch s := dest.(type) {
-	case *string:
-		return &sql.NullString{String: *s, Valid: n.Valid}
-	case *bool:
-		return &sql.NullBool{Bool: *s, Valid: n.Valid}
-	case *float64:
-		return &sql.NullFloat64{Float64: *s, Valid: n.Valid}
-	case *float32:
-		return &sql.NullFloat64{Float64: float64(*s), Valid: n.Valid}
-	case *int16:
-		return &sql.NullInt16{Int16: *s, Valid: n.Valid}
-	case *int32:
-		return &sql.NullInt32{Int32: *s, Valid: n.Valid}
-	case *int:
-		return &sql.NullInt32{Int32: int32(*s), Valid: n.Valid}
-	case *int64:
-		return &sql.NullInt64{Int64: *s, Valid: n.Valid}
-	case *time.Time:
-		return &sql.NullTime{Time: *s, Valid: n.Valid}
+func (n *Nullable[T]) Scan(value any) error {
+	if err := n.scan(value); err != nil {
+		return err
 	}
-
-	return nil
-}
-
-func (n *Nullable[T]) getScannerValue(scanner sql.Scanner) {
-	switch s := scanner.(type) {
-	case *sql.NullString:
-		n.Valid = s.Valid
-		n.Data = any(s.String).(T)
-	case *sql.NullBool:
-		n.Valid = s.Valid
-		n.Data = any(s.Bool).(T)
-	case *sql.NullFloat64:
-		n.Valid = s.Valid
-		switch any(n.Data).(type) {
-		case float32:
-			n.Data = any(float32(s.Float64)).(T)
-		case float64:
-			n.Data = any(s.Float64).(T)
-		}
-	case *sql.NullInt16:
-		n.Valid = s.Valid
-		n.Data = any(s.Int16).(T)
-	case *sql.NullInt32:
-		n.Valid = s.Valid
-		switch any(n.Data).(type) {
-		case int:
-			n.Data = any(int(s.Int32)).(T)
-		case int32:
-			n.Data = any(s.Int32).(T)
-		}
-	case *sql.NullInt64:
-		n.Valid = s.Valid
-		n.Data = any(s.Int64).(T)
-	case *sql.NullTime:
-		n.Valid = s.Valid
-		n.Data = any(s.Time).(T)
+	if err := n.validateNonFinite(); err != nil {
+		return err
 	}
+	return n.validateEnum()
 }
 
-func (n *Nullable[T]) Scan(value any) error {
+func (n *Nullable[T]) scan(value any) error {
 	if value == nil {
 		n.Valid = false
 		return nil
 	}
 
-	scanner := n.getScanner()
-	if scanner == nil {
-		n.Valid = false
-		var ref T
-		return fmt.Errorf("no scanner available for %T", ref)
+	plan := planOf[T]()
+	if plan.sqlCodec != nil {
+		return n.decodeWith(func() (T, error) { return plan.sqlCodec.decode(value) })
 	}
-	err := scanner.Scan(value)
-	if err != nil {
-		n.Valid = false
-		return err
+	if plan.mapping != nil {
+		data, err := plan.mapping.scan(value)
+		if err != nil {
+			n.Valid = false
+			return err
+		}
+		n.Data = data.(T)
+		n.Valid = true
+		return nil
 	}
 
-	n.getScannerValue(scanner)
-	return nil
+	var err error
+	switch plan.scan {
+	case scanWithScanner:
+		err = any(&n.Data).(sql.Scanner).Scan(value)
+	case scanWithArray:
+		err = scanReflectArray(reflect.ValueOf(&n.Data).Elem(), value)
+	case scanWithBuiltIn:
+		err = scanBuiltIn(any(&n.Data), value)
+	case scanWithText:
+		return n.scanTextColumn(value)
+	case scanWithJSON:
+		return n.scanJSONColumn(value)
+	case scanWithKind:
+		_, err = scanKind(reflect.ValueOf(&n.Data).Elem(), value, "")
+	default:
+		var ref T
+		err = fmt.Errorf("no scanner available for %T", ref)
+	}
+	n.Valid = err == nil
+	return err
+}
+
+// scanBuiltIn Scan src into dest, a pointer to one of the types of isBuiltInScanType. The common source types are
+// converted directly, so nothing is allocated per row. Other sources are converted like database/sql does
+func scanBuiltIn(dest any, src any) error {
+	switch d := dest.(type) {
+	case *string:
+		switch s := src.(type) {
+		case string:
+			*d = s
+			return nil
+		case []byte:
+			*d = string(s)
+			return nil
+		}
+		return scanInto(src, func(v string) error { *d = v; return nil })
+	case *bool:
+		if s, ok := src.(bool); ok {
+			*d = s
+			return nil
+		}
+		return scanInto(src, func(v bool) error { *d = v; return nil })
+	case *float64:
+		switch s := src.(type) {
+		case float64:
+			*d = s
+			return nil
+		case int64:
+			*d = float64(s)
+			return nil
+		}
+		return scanInto(src, func(v float64) error { *d = v; return nil })
+	case *float32:
+		switch s := src.(type) {
+		case float64:
+			*d = float32(s)
+			return nil
+		case int64:
+			*d = float32(s)
+			return nil
+		}
+		return scanInto(src, func(v float64) error { *d = float32(v); return nil })
+	case *int64:
+		if s, ok := src.(int64); ok {
+			*d = s
+			return nil
+		}
+		return scanInto(src, func(v int64) error { *d = v; return nil })
+	case *int:
+		if s, ok := src.(int64); ok && int64(int(s)) == s {
+			*d = int(s)
+			return nil
+		}
+		return scanInto(src, func(v int) error { *d = v; return nil })
+	case *int32:
+		if s, ok := src.(int64); ok && int64(int32(s)) == s {
+			*d = int32(s)
+			return nil
+		}
+		return scanInto(src, func(v int32) error { *d = v; return nil })
+	case *int16:
+		if s, ok := src.(int64); ok && int64(int16(s)) == s {
+			*d = int16(s)
+			return nil
+		}
+		return scanInto(src, func(v int16) error { *d = v; return nil })
+	case *time.Time:
+		var scanner timeScanner
+		if err := scanner.Scan(src); err != nil {
+			return err
+		}
+		*d = scanner.Time
+		return nil
+	}
+	return fmt.Errorf("null: cannot scan into %T", dest)
 }
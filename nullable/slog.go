@@ -0,0 +1,77 @@
+package nullable
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogValue Implement slog.LogValuer, logging NULL as a nil value and valid data as itself
+func (n Nullable[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return slog.AnyValue(nil)
+	}
+	if valuer, ok := any(n.Data).(slog.LogValuer); ok {
+		return valuer.LogValue()
+	}
+	return slog.AnyValue(n.Data)
+}
+
+// omitNullHandler is a slog.Handler that drops attributes with a NULL value
+type omitNullHandler struct {
+	handler slog.Handler
+}
+
+// NewOmitNullHandler Wrap a slog.Handler so attributes that resolve to NULL are not logged
+func NewOmitNullHandler(handler slog.Handler) slog.Handler {
+	return &omitNullHandler{handler: handler}
+}
+
+func (h *omitNullHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *omitNullHandler) Handle(ctx context.Context, record slog.Record) error {
+	filtered := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr, ok := omitNullAttr(attr); ok {
+			filtered.AddAttrs(attr)
+		}
+		return true
+	})
+	return h.handler.Handle(ctx, filtered)
+}
+
+func (h *omitNullHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &omitNullHandler{handler: h.handler.WithAttrs(omitNullAttrs(attrs))}
+}
+
+func (h *omitNullHandler) WithGroup(name string) slog.Handler {
+	return &omitNullHandler{handler: h.handler.WithGroup(name)}
+}
+
+func omitNullAttrs(attrs []slog.Attr) []slog.Attr {
+	filtered := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr, ok := omitNullAttr(attr); ok {
+			filtered = append(filtered, attr)
+		}
+	}
+	return filtered
+}
+
+func omitNullAttr(attr slog.Attr) (slog.Attr, bool) {
+	attr.Value = attr.Value.Resolve()
+	switch attr.Value.Kind() {
+	case slog.KindAny:
+		if attr.Value.Any() == nil {
+			return attr, false
+		}
+	case slog.KindGroup:
+		attrs := omitNullAttrs(attr.Value.Group())
+		if len(attrs) == 0 {
+			return attr, false
+		}
+		attr.Value = slog.GroupValue(attrs...)
+	}
+	return attr, true
+}
@@ -0,0 +1,78 @@
+package nullable
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+type logUser struct {
+	Name string
+}
+
+func (u logUser) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("name", u.Name))
+}
+
+func newTestLogger(buf *bytes.Buffer, json bool, omitNull bool) *slog.Logger {
+	removeTime := func(groups []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey && len(groups) == 0 {
+			return slog.Attr{}
+		}
+		return a
+	}
+	options := &slog.HandlerOptions{ReplaceAttr: removeTime}
+
+	var handler slog.Handler
+	if json {
+		handler = slog.NewJSONHandler(buf, options)
+	} else {
+		handler = slog.NewTextHandler(buf, options)
+	}
+	if omitNull {
+		handler = NewOmitNullHandler(handler)
+	}
+	return slog.New(handler)
+}
+
+func Test_Slog_text(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, false, false)
+	logger.Info("task", "project", Value(5), "category", Null[int]())
+	assert.Equal(t, "level=INFO msg=task project=5 category=<nil>\n", buf.String())
+}
+
+func Test_Slog_json(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, true, false)
+	logger.Info("task", "project", Value(5), "category", Null[int](), "deadline", Value(timeValue1))
+	assert.JSONEq(t, `{"level":"INFO","msg":"task","project":5,"category":null,"deadline":"2012-12-21T21:21:21Z"}`, buf.String())
+}
+
+func Test_Slog_nested_LogValuer(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, true, false)
+	logger.Info("login", "user", Value(logUser{Name: "John"}))
+	assert.JSONEq(t, `{"level":"INFO","msg":"login","user":{"name":"John"}}`, buf.String())
+}
+
+func Test_Slog_omit_null_text(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, false, true)
+	logger.Info("task", "project", Value(5), "category", Null[int]())
+	assert.Equal(t, "level=INFO msg=task project=5\n", buf.String())
+}
+
+func Test_Slog_omit_null_json(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, true, true).
+		With("department", Null[int](), "coworker", Value(3)).
+		WithGroup("task")
+	logger.Info("created",
+		"project", Value(5),
+		"category", Null[int](),
+		slog.Group("notes", "text", Null[string]()),
+	)
+	assert.JSONEq(t, `{"level":"INFO","msg":"created","coworker":3,"task":{"project":5}}`, buf.String())
+}
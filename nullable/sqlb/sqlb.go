@@ -0,0 +1,211 @@
+// Package sqlb builds NULL-safe SQL predicates and assignments from Nullable values.
+//
+// Comparing a column to a NULL argument with "col = ?" never matches, so the predicates
+// in this package render "col IS NULL" when the Nullable is NULL and "col = ?" otherwise.
+package sqlb
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Uffe-Code/go-nullable/nullable"
+)
+
+// Placeholder is the style of bind parameters used when building SQL
+type Placeholder int
+
+const (
+	// Question renders bind parameters as ?, used by MySQL and SQLite
+	Question Placeholder = iota
+	// Dollar renders bind parameters as $1, $2, ..., used by PostgreSQL
+	Dollar
+	// AtName renders bind parameters as @p1, @p2, ... with sql.Named arguments, used by SQL Server
+	AtName
+)
+
+// Expr is a SQL fragment with its bind arguments. Bind parameters are written as ? until the Expr is built
+type Expr struct {
+	sql  string
+	args []any
+	err  error
+}
+
+// Raw Create an Expr from a SQL fragment, where every ? outside of quotes and comments is a bind parameter
+// for the matching argument
+func Raw(sql string, args ...any) Expr {
+	return Expr{sql: sql, args: args}
+}
+
+// Eq Create the predicate column = value, or column IS NULL if value is NULL
+func Eq[T any](column string, value nullable.Nullable[T]) Expr {
+	if !value.Valid {
+		return Expr{sql: column + " IS NULL"}
+	}
+	return bind(column+" = ?", value)
+}
+
+// Ne Create the predicate column IS DISTINCT FROM value, matching NULL rows when value is not NULL
+func Ne[T any](column string, value nullable.Nullable[T]) Expr {
+	if !value.Valid {
+		return Expr{sql: column + " IS NOT NULL"}
+	}
+	return bind("("+column+" <> ? OR "+column+" IS NULL)", value)
+}
+
+// In Create the predicate column IN (values...), adding OR column IS NULL if any of the values is NULL
+func In[T any](column string, values []nullable.Nullable[T]) Expr {
+	var hasNull bool
+	var placeholders []string
+	var args []any
+	for _, value := range values {
+		if !value.Valid {
+			hasNull = true
+			continue
+		}
+		arg, err := value.Value()
+		if err != nil {
+			return Expr{err: fmt.Errorf("sqlb: could not get value for %s: %w", column, err)}
+		}
+		placeholders = append(placeholders, "?")
+		args = append(args, arg)
+	}
+
+	in := column + " IN (" + strings.Join(placeholders, ", ") + ")"
+	switch {
+	case len(args) == 0 && hasNull:
+		return Expr{sql: column + " IS NULL"}
+	case len(args) == 0:
+		return Expr{sql: "1 = 0"}
+	case hasNull:
+		return Expr{sql: "(" + in + " OR " + column + " IS NULL)", args: args}
+	}
+	return Expr{sql: in, args: args}
+}
+
+// Set Create the assignment column = value for UPDATE statements, binding NULL if value is NULL
+func Set[T any](column string, value nullable.Nullable[T]) Expr {
+	return bind(column+" = ?", value)
+}
+
+// And Join predicates with AND. An empty list is always true
+func And(exprs ...Expr) Expr {
+	if len(exprs) == 0 {
+		return Expr{sql: "1 = 1"}
+	}
+	return join(" AND ", exprs)
+}
+
+// Or Join predicates with OR, wrapped in parentheses. An empty list is always false
+func Or(exprs ...Expr) Expr {
+	if len(exprs) == 0 {
+		return Expr{sql: "1 = 0"}
+	}
+	expr := join(" OR ", exprs)
+	expr.sql = "(" + expr.sql + ")"
+	return expr
+}
+
+// List Join expressions with commas, as used for the assignments of a SET clause
+func List(exprs ...Expr) Expr {
+	return join(", ", exprs)
+}
+
+// Concat Join expressions without a separator, as used for building full statements
+func Concat(exprs ...Expr) Expr {
+	return join("", exprs)
+}
+
+// Build Render the SQL with bind parameters in the given style, and get the arguments.
+// A ? in a quoted string or identifier, or in a -- or /* */ comment, is not a bind parameter.
+// Backslash escapes in MySQL strings and PostgreSQL dollar-quoted strings are not recognized,
+// so a ? in them must be passed as an argument instead
+func (e Expr) Build(style Placeholder) (string, []any, error) {
+	if e.err != nil {
+		return "", nil, e.err
+	}
+
+	var sb strings.Builder
+	args := make([]any, 0, len(e.args))
+	for i := 0; i < len(e.sql); i++ {
+		if end := skipLiteral(e.sql, i); end > i {
+			sb.WriteString(e.sql[i:end])
+			i = end - 1
+			continue
+		}
+		if e.sql[i] != '?' {
+			sb.WriteByte(e.sql[i])
+			continue
+		}
+		if len(args) == len(e.args) {
+			return "", nil, fmt.Errorf("sqlb: more bind parameters than the %d arguments", len(e.args))
+		}
+
+		position := strconv.Itoa(len(args) + 1)
+		arg := e.args[len(args)]
+		switch style {
+		case Dollar:
+			sb.WriteString("$" + position)
+		case AtName:
+			sb.WriteString("@p" + position)
+			arg = sql.Named("p"+position, arg)
+		default:
+			sb.WriteRune('?')
+		}
+		args = append(args, arg)
+	}
+
+	if len(args) != len(e.args) {
+		return "", nil, fmt.Errorf("sqlb: %d bind parameters for %d arguments", len(args), len(e.args))
+	}
+	return sb.String(), args, nil
+}
+
+// String Get the SQL of the expression with ? bind parameters
+func (e Expr) String() string {
+	return e.sql
+}
+
+// skipLiteral Get the end of the quoted string, quoted identifier or comment starting at i,
+// or i if there is none. Doubled quotes are part of the literal, and unterminated ones run to the end
+func skipLiteral(sql string, i int) int {
+	var end string
+	switch {
+	case sql[i] == '\'' || sql[i] == '"' || sql[i] == '`':
+		end = sql[i : i+1]
+	case strings.HasPrefix(sql[i:], "--"):
+		end = "\n"
+	case strings.HasPrefix(sql[i:], "/*"):
+		end = "*/"
+	default:
+		return i
+	}
+
+	if j := strings.Index(sql[i+1:], end); j >= 0 {
+		return i + 1 + j + len(end)
+	}
+	return len(sql)
+}
+
+func bind[T any](sql string, value nullable.Nullable[T]) Expr {
+	arg, err := value.Value()
+	if err != nil {
+		return Expr{err: fmt.Errorf("sqlb: could not get value for %s: %w", sql, err)}
+	}
+	return Expr{sql: sql, args: []any{arg}}
+}
+
+func join(separator string, exprs []Expr) Expr {
+	var joined Expr
+	parts := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr.err != nil {
+			return Expr{err: expr.err}
+		}
+		parts = append(parts, expr.sql)
+		joined.args = append(joined.args, expr.args...)
+	}
+	joined.sql = strings.Join(parts, separator)
+	return joined
+}
@@ -0,0 +1,92 @@
+package sqlb
+
+import (
+	"database/sql"
+	"github.com/Uffe-Code/go-nullable/nullable"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func assertBuild(t *testing.T, expr Expr, style Placeholder, expectedSql string, expectedArgs ...any) {
+	t.Helper()
+	query, args, err := expr.Build(style)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSql, query)
+	if len(expectedArgs) == 0 {
+		assert.Empty(t, args)
+		return
+	}
+	assert.Equal(t, expectedArgs, args)
+}
+
+func Test_Eq(t *testing.T) {
+	assertBuild(t, Eq("project_id", nullable.Value(5)), Question, "project_id = ?", 5)
+	assertBuild(t, Eq("project_id", nullable.Null[int]()), Question, "project_id IS NULL")
+}
+
+func Test_Ne(t *testing.T) {
+	assertBuild(t, Ne("project_id", nullable.Value(5)), Question, "(project_id <> ? OR project_id IS NULL)", 5)
+	assertBuild(t, Ne("project_id", nullable.Null[int]()), Question, "project_id IS NOT NULL")
+}
+
+func Test_In(t *testing.T) {
+	values := []nullable.Nullable[int]{nullable.Value(1), nullable.Value(2)}
+	assertBuild(t, In("project_id", values), Dollar, "project_id IN ($1, $2)", 1, 2)
+
+	values = append(values, nullable.Null[int]())
+	assertBuild(t, In("project_id", values), Dollar, "(project_id IN ($1, $2) OR project_id IS NULL)", 1, 2)
+
+	assertBuild(t, In("project_id", []nullable.Nullable[int]{nullable.Null[int]()}), Dollar, "project_id IS NULL")
+	assertBuild(t, In[int]("project_id", nil), Dollar, "1 = 0")
+}
+
+func Test_Set(t *testing.T) {
+	set := List(Set("subject", nullable.Value("kjell")), Set("project_id", nullable.Null[int]()))
+	assertBuild(t, set, Dollar, "subject = $1, project_id = $2", "kjell", nil)
+}
+
+func Test_And_Or(t *testing.T) {
+	expr := And(
+		Eq("task_id", nullable.Value(1)),
+		Or(Eq("project_id", nullable.Null[int]()), Eq("category_id", nullable.Value(3))),
+	)
+	assertBuild(t, expr, Dollar, "task_id = $1 AND (project_id IS NULL OR category_id = $2)", 1, 3)
+
+	assertBuild(t, And(), Question, "1 = 1")
+	assertBuild(t, Or(), Question, "1 = 0")
+}
+
+func Test_Placeholders(t *testing.T) {
+	expr := And(Eq("a", nullable.Value("x")), Raw("b > ?", 10))
+	assertBuild(t, expr, Question, "a = ? AND b > ?", "x", 10)
+	assertBuild(t, expr, Dollar, "a = $1 AND b > $2", "x", 10)
+	assertBuild(t, expr, AtName, "a = @p1 AND b > @p2", sql.Named("p1", "x"), sql.Named("p2", 10))
+}
+
+func Test_Placeholders_quoted(t *testing.T) {
+	expr := Raw(`a = '?' AND "b?" = ? AND c = 'it''s ?' -- d = ?
+AND e = ? /* f = ? */ AND `+"`g?`"+` = ?`, 1, 2, 3)
+	assertBuild(t, expr, Dollar, `a = '?' AND "b?" = $1 AND c = 'it''s ?' -- d = ?
+AND e = $2 /* f = ? */ AND `+"`g?`"+` = $3`, 1, 2, 3)
+
+	// An unterminated quote runs to the end
+	assertBuild(t, Raw("a = ? AND b = 'x?", 1), Dollar, "a = $1 AND b = 'x?", 1)
+}
+
+func Test_Raw_argument_mismatch(t *testing.T) {
+	_, _, err := Raw("a = ? AND b = ?", 1).Build(Question)
+	assert.Error(t, err)
+
+	_, _, err = Raw("a = 1", 1).Build(Question)
+	assert.Error(t, err)
+}
+
+func Test_Concat(t *testing.T) {
+	query := Concat(
+		Raw("UPDATE task SET "),
+		List(Set("project_id", nullable.Null[int]()), Set("subject", nullable.Value("kjell"))),
+		Raw(" WHERE "),
+		Eq("task_id", nullable.Value(1)),
+	)
+	assertBuild(t, query, Dollar, "UPDATE task SET project_id = $1, subject = $2 WHERE task_id = $3", nil, "kjell", 1)
+}
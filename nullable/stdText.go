@@ -0,0 +1,133 @@
+package nullable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// marshalTextStd Format the standard library types that have no MarshalText, or whose basic kind would give the wrong
+// text, like time.Duration. Only the text is affected: MarshalJSON keeps writing a time.Duration as integer
+// nanoseconds, like encoding/json does for a plain time.Duration, so JSON does not change for existing clients.
+// UnmarshalJSON reads both. Returns false if value is not one of them
+func marshalTextStd(value any) ([]byte, bool) {
+	switch v := value.(type) {
+	case time.Duration:
+		return []byte(v.String()), true
+	case *time.Location:
+		return []byte(v.String()), true
+	case url.URL:
+		return []byte(v.String()), true
+	case *url.URL:
+		if v == nil {
+			return []byte{}, true
+		}
+		return []byte(v.String()), true
+	case json.Number:
+		return []byte(v), true
+	case complex64:
+		return []byte(strconv.FormatComplex(complex128(v), 'f', -1, 64)), true
+	case complex128:
+		return []byte(strconv.FormatComplex(v, 'f', -1, 128)), true
+	}
+	return nil, false
+}
+
+// unmarshalTextStd Parse text into the standard library types handled by marshalTextStd.
+// Returns false if dest does not point to one of them
+func unmarshalTextStd(str string, dest any) (bool, error) {
+	var err error
+	switch d := dest.(type) {
+	case *time.Duration:
+		var duration time.Duration
+		if duration, err = time.ParseDuration(str); err != nil {
+			// Accept nanoseconds, as written by earlier versions
+			nanos, intErr := strconv.ParseInt(str, 10, 64)
+			if intErr != nil {
+				break
+			}
+			duration, err = time.Duration(nanos), nil
+		}
+		*d = duration
+	case **time.Location:
+		var loc *time.Location
+		if loc, err = time.LoadLocation(str); err == nil {
+			*d = loc
+		}
+	case *url.URL:
+		var u *url.URL
+		if u, err = url.Parse(str); err == nil {
+			*d = *u
+		}
+	case **url.URL:
+		*d, err = url.Parse(str)
+	case *json.Number:
+		if !isJSONNumber(str) {
+			err = errors.New("invalid number " + strconv.Quote(str))
+			break
+		}
+		*d = json.Number(str)
+	case *complex64:
+		var c complex128
+		if c, err = strconv.ParseComplex(str, 64); err == nil {
+			*d = complex64(c)
+		}
+	case *complex128:
+		*d, err = strconv.ParseComplex(str, 128)
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return true, fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	return true, nil
+}
+
+// isJSONTextType Check if value is written as a JSON string of its text, since encoding/json cannot write it
+func isJSONTextType(value any) bool {
+	switch value.(type) {
+	case url.URL, *url.URL, *time.Location, complex64, complex128:
+		return true
+	}
+	return false
+}
+
+// isJSONNumber Check if str is a number in the JSON grammar, like -1.5e3
+func isJSONNumber(str string) bool {
+	i := 0
+	digits := func() bool {
+		start := i
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+		}
+		return i > start
+	}
+
+	if i < len(str) && str[i] == '-' {
+		i++
+	}
+	if i < len(str) && str[i] == '0' {
+		i++
+	} else if !digits() {
+		return false
+	}
+	if i < len(str) && str[i] == '.' {
+		i++
+		if !digits() {
+			return false
+		}
+	}
+	if i < len(str) && (str[i] == 'e' || str[i] == 'E') {
+		i++
+		if i < len(str) && (str[i] == '+' || str[i] == '-') {
+			i++
+		}
+		if !digits() {
+			return false
+		}
+	}
+	return i == len(str)
+}
@@ -0,0 +1,150 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type pointerText struct {
+	value string
+}
+
+func (p *pointerText) MarshalText() ([]byte, error) {
+	return []byte("[" + p.value + "]"), nil
+}
+
+func (p *pointerText) UnmarshalText(text []byte) error {
+	p.value = string(text[1 : len(text)-1])
+	return nil
+}
+
+type stringerOnly struct {
+	a, b int
+}
+
+func (s stringerOnly) String() string {
+	return "a-b"
+}
+
+func assertTextRoundTrip[T any](t *testing.T, value T, expected string) {
+	text, err := Value(value).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(text))
+
+	var n Nullable[T]
+	assert.NoError(t, n.UnmarshalText(text))
+	assert.True(t, n.Valid)
+	assert.Equal(t, Value(value), n)
+}
+
+func Test_Text_std(t *testing.T) {
+	assertTextRoundTrip(t, 90*time.Minute, "1h30m0s")
+	assertTextRoundTrip(t, json.Number("12.5e3"), "12.5e3")
+	assertTextRoundTrip(t, complex(1.5, -2), "(1.5-2i)")
+	assertTextRoundTrip(t, complex64(complex(0.1, 3)), "(0.1+3i)")
+	assertTextRoundTrip(t, netip.MustParseAddr("2001:db8::1"), "2001:db8::1")
+	assertTextRoundTrip(t, netip.MustParsePrefix("10.0.0.0/8"), "10.0.0.0/8")
+	assertTextRoundTrip(t, net.ParseIP("192.168.0.1"), "192.168.0.1")
+	assertTextRoundTrip(t, time.UTC, "UTC")
+
+	u, _ := url.Parse("https://example.com/a?b=c#d")
+	assertTextRoundTrip(t, *u, "https://example.com/a?b=c#d")
+	assertTextRoundTrip(t, u, "https://example.com/a?b=c#d")
+
+	var d Nullable[time.Duration]
+	assert.NoError(t, d.UnmarshalText([]byte("1500")))
+	assert.Equal(t, Value(1500*time.Nanosecond), d)
+	assert.Error(t, d.UnmarshalText([]byte("soon")))
+	assert.False(t, d.Valid)
+
+	var number Nullable[json.Number]
+	assert.Error(t, number.UnmarshalText([]byte("12a")))
+	var c Nullable[complex128]
+	assert.Error(t, c.UnmarshalText([]byte("1+")))
+	var loc Nullable[*time.Location]
+	assert.Error(t, loc.UnmarshalText([]byte("Nowhere/Special")))
+}
+
+func Test_Text_pointerReceiver(t *testing.T) {
+	text, err := Value(pointerText{"x"}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "[x]", string(text))
+
+	var n Nullable[pointerText]
+	assert.NoError(t, n.UnmarshalText([]byte("[y]")))
+	assert.Equal(t, Value(pointerText{"y"}), n)
+
+	data, err := json.Marshal(Value(pointerText{"z"}))
+	assert.NoError(t, err)
+	assert.Equal(t, `"[z]"`, string(data))
+}
+
+func Test_Text_stringer(t *testing.T) {
+	text, err := Value(stringerOnly{1, 2}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "a-b", string(text))
+
+	var n Nullable[stringerOnly]
+	assert.Error(t, n.UnmarshalText([]byte("a-b")))
+}
+
+func Test_JSON_std(t *testing.T) {
+	u, _ := url.Parse("https://example.com/path")
+	loc, _ := time.LoadLocation("Europe/Stockholm")
+	type record struct {
+		Link    Nullable[url.URL]        `json:"link"`
+		Zone    Nullable[*time.Location] `json:"zone"`
+		Signal  Nullable[complex128]     `json:"signal"`
+		Timeout Nullable[time.Duration]  `json:"timeout"`
+		Amount  Nullable[json.Number]    `json:"amount"`
+		Address Nullable[netip.Addr]     `json:"address"`
+		Missing Nullable[url.URL]        `json:"missing"`
+	}
+	r := record{
+		Link:    Value(*u),
+		Zone:    Value(loc),
+		Signal:  Value(complex(1, 2)),
+		Timeout: Value(time.Second),
+		Amount:  Value(json.Number("123456789012345678901")),
+		Address: Value(netip.MustParseAddr("127.0.0.1")),
+	}
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"link":"https://example.com/path","zone":"Europe/Stockholm","signal":"(1+2i)","timeout":1000000000,`+
+		`"amount":123456789012345678901,"address":"127.0.0.1","missing":null}`, string(data))
+
+	var decoded record
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, r, decoded)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"timeout":"2m"}`), &decoded))
+	assert.Equal(t, Value(2*time.Minute), decoded.Timeout)
+}
+
+func Test_JSON_duration_nanoseconds(t *testing.T) {
+	// JSON of a Duration is integer nanoseconds like encoding/json, while its text is the readable form
+	duration := 90 * time.Minute
+	expected, err := json.Marshal(duration)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(Value(duration))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(data))
+	assert.Equal(t, "5400000000000", string(data))
+
+	text, err := Value(duration).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1h30m0s", string(text))
+
+	for _, input := range []string{`5400000000000`, `"1h30m0s"`, `"1h30m"`} {
+		var decoded Nullable[time.Duration]
+		assert.NoError(t, json.Unmarshal([]byte(input), &decoded), input)
+		assert.Equal(t, Value(duration), decoded, input)
+	}
+}
@@ -0,0 +1,266 @@
+package nullable
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+	// structFieldCache maps a struct type to its fields by lower case column name
+	structFieldCache sync.Map
+)
+
+// ScanStruct Scan the current row into the struct pointed to by dst. Columns are matched against the
+// `db` tag of each field, or the field name if it has no tag, ignoring case. Fields tagged `db:"-"` are skipped.
+// Nullable fields are scanned with their Scan method, and scanning NULL into a non-nullable field is an error
+func ScanStruct(rows *sql.Rows, dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: ScanStruct needs a non-nil pointer to a struct, not %T", dst)
+	}
+
+	plan, err := newScanPlan(rows, value.Elem().Type())
+	if err != nil {
+		return err
+	}
+	return plan.scan(rows, value.Elem())
+}
+
+// ScanAll Scan all rows into a slice of structs, using the same column mapping as ScanStruct. The rows are closed when done
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	var ref T
+	structType := reflect.TypeOf(ref)
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: ScanAll needs a struct type, not %T", ref)
+	}
+
+	plan, err := newScanPlan(rows, structType)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []T
+	var item T
+	itemValue := reflect.ValueOf(&item).Elem()
+	for rows.Next() {
+		item = ref
+		if err = plan.scan(rows, itemValue); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// scanPlan is the field index of each column in a result set. The destinations are reused for every row
+type scanPlan struct {
+	columns []string
+	fields  [][]int
+	dests   []any
+	notNull []notNullScanner
+}
+
+func newScanPlan(rows *sql.Rows, structType reflect.Type) (*scanPlan, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := structFields(structType)
+	plan := &scanPlan{
+		columns: columns,
+		fields:  make([][]int, len(columns)),
+		dests:   make([]any, len(columns)),
+		notNull: make([]notNullScanner, len(columns)),
+	}
+	for i, column := range columns {
+		index, ok := fields[strings.ToLower(column)]
+		if !ok {
+			return nil, fmt.Errorf("null: no field in %s for column %q", structType, column)
+		}
+		plan.fields[i] = index
+	}
+	return plan, nil
+}
+
+func (p *scanPlan) scan(rows *sql.Rows, dst reflect.Value) error {
+	for i, column := range p.columns {
+		field := dst.FieldByIndex(p.fields[i])
+		dest := field.Addr().Interface()
+		if _, ok := dest.(sql.Scanner); !ok && field.Kind() != reflect.Pointer {
+			p.notNull[i] = notNullScanner{column: column, field: field}
+			dest = &p.notNull[i]
+		}
+		p.dests[i] = dest
+	}
+	return rows.Scan(p.dests...)
+}
+
+func structFields(structType reflect.Type) map[string][]int {
+	if fields, ok := structFieldCache.Load(structType); ok {
+		return fields.(map[string][]int)
+	}
+
+	fields := map[string][]int{}
+	addStructFields(fields, structType, nil)
+	structFieldCache.Store(structType, fields)
+	return fields
+}
+
+func addStructFields(fields map[string][]int, structType reflect.Type, parent []int) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+
+		index := append(append([]int{}, parent...), i)
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct && !reflect.PointerTo(field.Type).Implements(scannerType) {
+			addStructFields(fields, field.Type, index)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag != "" {
+			name = tag
+		}
+		name = strings.ToLower(name)
+		if _, exists := fields[name]; !exists || len(index) < len(fields[name]) {
+			fields[name] = index
+		}
+	}
+}
+
+// notNullScanner scans into a field that cannot hold NULL
+type notNullScanner struct {
+	column string
+	field  reflect.Value
+}
+
+func (s *notNullScanner) Scan(src any) error {
+	if src == nil {
+		return fmt.Errorf("null: column %q is NULL, but the field of type %s is not nullable", s.column, s.field.Type())
+	}
+
+	if codec, ok := sqlCodecs.Load(s.field.Type()); ok {
+		value, err := codec.(interface{ scanAny(any) (any, error) }).scanAny(src)
+		if err != nil {
+			return &scanColumnError{column: s.column, err: err}
+		}
+		if value == nil {
+			// A nil interface or pointer from a codec of an interface type
+			s.field.Set(reflect.Zero(s.field.Type()))
+			return nil
+		}
+		s.field.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	if isBuiltInScanType(s.field.Type()) {
+		if err := scanBuiltIn(s.field.Addr().Interface(), src); err != nil {
+			return &scanColumnError{column: s.column, err: err}
+		}
+		return nil
+	}
+
+	if ok, err := scanKind(s.field, src, s.column); ok {
+		return err
+	}
+
+	switch s.field.Type() {
+	case reflect.TypeOf(time.Time{}):
+		return scanInto(src, func(v time.Time) error { s.field.Set(reflect.ValueOf(v)); return nil })
+	}
+
+	value := reflect.ValueOf(src)
+	if !value.Type().ConvertibleTo(s.field.Type()) {
+		return fmt.Errorf("null: cannot scan %T into field of type %s for column %q", src, s.field.Type(), s.column)
+	}
+	s.field.Set(value.Convert(s.field.Type()))
+	return nil
+}
+
+// scanColumnError is an error scanning a column in ScanStruct, which does not repeat the null: prefix of err
+type scanColumnError struct {
+	column string
+	err    error
+}
+
+func (e *scanColumnError) Error() string {
+	return fmt.Sprintf("null: could not scan column %q: %s", e.column, strings.TrimPrefix(e.err.Error(), "null: "))
+}
+
+func (e *scanColumnError) Unwrap() error {
+	return e.err
+}
+
+// scanKind Scan src into dest if dest has a basic kind, like a string, an integer or a byte slice, also for named
+// types like json.RawMessage. Byte slices are copied, since drivers may reuse their buffers.
+// Overflow errors name the column, if it is not blank. Returns false if the kind of dest is not supported
+func scanKind(dest reflect.Value, src any, column string) (bool, error) {
+	overflow := func(value any) error {
+		if column != "" {
+			return fmt.Errorf("null: value %v of column %q overflows %s", value, column, dest.Type())
+		}
+		return fmt.Errorf("null: value %v overflows %s", value, dest.Type())
+	}
+
+	switch dest.Kind() {
+	case reflect.String:
+		return true, scanInto(src, func(v string) error { dest.SetString(v); return nil })
+	case reflect.Bool:
+		return true, scanInto(src, func(v bool) error { dest.SetBool(v); return nil })
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true, scanInto(src, func(v int64) error {
+			if dest.OverflowInt(v) {
+				return overflow(v)
+			}
+			dest.SetInt(v)
+			return nil
+		})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true, scanInto(src, func(v uint64) error {
+			if dest.OverflowUint(v) {
+				return overflow(v)
+			}
+			dest.SetUint(v)
+			return nil
+		})
+	case reflect.Float32, reflect.Float64:
+		return true, scanInto(src, func(v float64) error {
+			if dest.OverflowFloat(v) {
+				return overflow(v)
+			}
+			dest.SetFloat(v)
+			return nil
+		})
+	case reflect.Slice:
+		if dest.Type().Elem().Kind() == reflect.Uint8 {
+			return true, scanInto(src, func(v []byte) error { dest.SetBytes(v); return nil })
+		}
+	}
+	return false, nil
+}
+
+func scanInto[V any](src any, set func(V) error) error {
+	var scanner sql.Null[V]
+	if err := scanner.Scan(src); err != nil {
+		return err
+	}
+	return set(scanner.V)
+}
@@ -0,0 +1,95 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type scanBase struct {
+	Id      int64
+	Created time.Time `db:"created_at"`
+}
+
+type scanTask struct {
+	scanBase
+	Subject    string           `db:"subject"`
+	ProjectId  Nullable[int]    `db:"project_id"`
+	Notes      Nullable[string] `db:"notes"`
+	Estimate   *float64         `db:"estimate"`
+	Priority   uint8            `db:"priority"`
+	Ignored    string           `db:"-"`
+	unexported string
+}
+
+var scanTaskColumns = []string{"id", "created_at", "subject", "project_id", "notes", "estimate", "priority"}
+
+func Test_ScanStruct(t *testing.T) {
+	rows := queryTestRows(t, scanTaskColumns,
+		[]driver.Value{int64(1), timeValue1, []byte("kjell"), int64(5), nil, 1.5, int64(2)},
+	)
+	defer rows.Close()
+
+	assert.True(t, rows.Next())
+	var tsk scanTask
+	err := ScanStruct(rows, &tsk)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), tsk.Id)
+	assert.Equal(t, timeValue1, tsk.Created)
+	assert.Equal(t, "kjell", tsk.Subject)
+	assert.Equal(t, Value(5), tsk.ProjectId)
+	assert.False(t, tsk.Notes.Valid)
+	assert.Equal(t, 1.5, *tsk.Estimate)
+	assert.Equal(t, uint8(2), tsk.Priority)
+}
+
+func Test_ScanAll(t *testing.T) {
+	rows := queryTestRows(t, []string{"Subject", "PROJECT_ID", "estimate"},
+		[]driver.Value{"a", int64(5), nil},
+		[]driver.Value{"b", nil, 2.5},
+	)
+
+	tasks, err := ScanAll[scanTask](rows)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "a", tasks[0].Subject)
+	assert.Equal(t, Value(5), tasks[0].ProjectId)
+	assert.Nil(t, tasks[0].Estimate)
+	assert.Equal(t, "b", tasks[1].Subject)
+	assert.False(t, tasks[1].ProjectId.Valid)
+	assert.Equal(t, 2.5, *tasks[1].Estimate)
+}
+
+func Test_ScanAll_null_in_non_nullable_field(t *testing.T) {
+	rows := queryTestRows(t, []string{"subject"}, []driver.Value{nil})
+
+	_, err := ScanAll[scanTask](rows)
+	assert.ErrorContains(t, err, `column "subject" is NULL`)
+}
+
+func Test_ScanAll_unknown_column(t *testing.T) {
+	rows := queryTestRows(t, []string{"subject", "unknown"}, []driver.Value{"a", "b"})
+
+	_, err := ScanAll[scanTask](rows)
+	assert.ErrorContains(t, err, `column "unknown"`)
+}
+
+func Test_ScanAll_overflow(t *testing.T) {
+	rows := queryTestRows(t, []string{"priority"}, []driver.Value{int64(300)})
+
+	_, err := ScanAll[scanTask](rows)
+	assert.ErrorContains(t, err, `column "priority" overflows uint8`)
+}
+
+func Test_ScanStruct_bad_destination(t *testing.T) {
+	rows := queryTestRows(t, []string{"subject"}, []driver.Value{"a"})
+	defer rows.Close()
+
+	var tsk scanTask
+	assert.Error(t, ScanStruct(rows, tsk))
+	assert.Error(t, ScanStruct(rows, (*scanTask)(nil)))
+
+	_, err := ScanAll[int](rows)
+	assert.Error(t, err)
+}
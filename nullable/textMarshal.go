@@ -2,8 +2,10 @@ package nullable
 
 import (
 	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 )
 
@@ -12,76 +14,81 @@ func (n Nullable[T]) MarshalText() ([]byte, error) {
 		return []byte{}, nil
 	}
 
+	if codec := lookupTextCodec[T](); codec != nil {
+		return codec.encode(n.Data)
+	}
+	if mapping := lookupMapping[T](); mapping != nil {
+		return mapping.text(n.Data)
+	}
+
 	value := any(n.Data)
 	txt, ok := value.(encoding.TextMarshaler)
 	if ok {
 		return txt.MarshalText()
 	}
-
-	switch any(n.Data).(type) {
-	case float32, float64:
-		return marshalTextFloat(n)
-	case bool:
-		return marshalTextBool(any(n).(Nullable[bool]))
-	case int, int8, int16, int32, int64:
-		return marshalTextInt(n)
-	case string:
-		return []byte(any(n.Data).(string)), nil
+	if txt, ok := any(&n.Data).(encoding.TextMarshaler); ok {
+		return txt.MarshalText()
+	}
+	if text, ok := marshalTextStd(value); ok {
+		return text, nil
 	}
 
-	var ref T
-	return []byte{}, fmt.Errorf("type %T cannot be marshalled to text", ref)
-}
-
-func marshalTextInt[T any](f Nullable[T]) ([]byte, error) {
-	if !f.Valid {
-		return []byte{}, nil
+	if text, ok, err := appendTextBuiltIn([]byte{}, any(&n.Data), floatFormatOf[T](), planOf[T]().nonFinite); ok {
+		return text, err
 	}
 
-	var value int64
-	switch any(f.Data).(type) {
-	case int:
-		value = int64(any(f.Data).(int))
-	case int8:
-		value = int64(any(f.Data).(int8))
-	case int16:
-		value = int64(any(f.Data).(int16))
-	case int32:
-		value = int64(any(f.Data).(int32))
-	case int64:
-		value = any(f.Data).(int64)
+	if text, ok := marshalTextKind(reflect.ValueOf(n.Data), floatFormatOf[T](), planOf[T]().bytesEncoding); ok {
+		return text, nil
+	}
+	if stringer, ok := value.(fmt.Stringer); ok {
+		// Only for writing, since the text of a Stringer can not be expected to be parsed back
+		return []byte(stringer.String()), nil
 	}
 
-	return []byte(strconv.FormatInt(value, 10)), nil
+	var ref T
+	return []byte{}, fmt.Errorf("type %T cannot be marshalled to text", ref)
 }
 
-func marshalTextFloat[T any](f Nullable[T]) ([]byte, error) {
-	if !f.Valid {
-		return []byte{}, nil
-	}
-
-	var value float64
-	switch any(f.Data).(type) {
-	case float32:
-		value = float64(any(f.Data).(float32))
-	case float64:
-		value = any(f.Data).(float64)
+// marshalTextKind Format a value with a basic kind as text, like a named string or integer type.
+// Floats are written in format, and byte slices are encoded with encoding
+func marshalTextKind(value reflect.Value, format FloatFormat, encoding BytesEncoding) ([]byte, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return []byte(value.String()), true
+	case reflect.Bool:
+		return []byte(strconv.FormatBool(value.Bool())), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []byte(strconv.FormatInt(value.Int(), 10)), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []byte(strconv.FormatUint(value.Uint(), 10)), true
+	case reflect.Float32, reflect.Float64:
+		return format.appendFloat(nil, value.Float(), value.Type().Bits()), true
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return encoding.encode(value.Bytes()), true
+		}
 	}
-
-	return []byte(strconv.FormatFloat(value, 'f', -1, 64)), nil
+	return nil, false
 }
 
-func marshalTextBool(b Nullable[bool]) ([]byte, error) {
-	if !b.Valid {
-		return []byte{}, nil
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	// A registered codec reads the text, before the names of an enum
+	if plan := planOf[T](); plan.enum != nil && plan.textCodec == nil {
+		if data, ok := lookupEnumName[T](string(text)); ok {
+			n.Data, n.Valid = data, true
+			return nil
+		}
 	}
-	if !b.Data {
-		return []byte("false"), nil
+	if err := n.unmarshalText(text); err != nil {
+		return err
 	}
-	return []byte("true"), nil
+	if err := n.validateNonFinite(); err != nil {
+		return err
+	}
+	return n.validateEnum()
 }
 
-func (n *Nullable[T]) UnmarshalText(text []byte) error {
+func (n *Nullable[T]) unmarshalText(text []byte) error {
 	value := any(&n.Data)
 	str := string(text)
 
@@ -90,6 +97,20 @@ func (n *Nullable[T]) UnmarshalText(text []byte) error {
 		return nil
 	}
 
+	if codec := lookupTextCodec[T](); codec != nil {
+		return n.decodeWith(func() (T, error) { return codec.decode(text) })
+	}
+	if mapping := lookupMapping[T](); mapping != nil {
+		data, err := mapping.scan(str)
+		if err != nil {
+			n.Valid = false
+			return err
+		}
+		n.Data = data.(T)
+		n.Valid = true
+		return nil
+	}
+
 	txt, ok := value.(encoding.TextUnmarshaler)
 	if ok {
 		err := txt.UnmarshalText(text)
@@ -101,107 +122,127 @@ func (n *Nullable[T]) UnmarshalText(text []byte) error {
 		return nil
 	}
 
-	switch any(n.Data).(type) {
-	case bool:
-		return unmarshalTextBool(str, any(n).(*Nullable[bool]))
-	case float32, float64:
-		return unmarshalTextFloat(str, n)
-	case int, int8, int16, int32, int64:
-		return unmarshalTextInt(str, n)
-	case string:
-		n.Data = any(str).(T)
-		n.Valid = str != ""
-		return nil
+	if ok, err := unmarshalTextStd(str, value); ok {
+		n.Valid = err == nil
+		return err
+	}
+
+	if ok, err := unmarshalTextBuiltIn(str, value); ok {
+		n.Valid = err == nil
+		return err
+	}
+
+	if ok, err := unmarshalTextKind(str, reflect.ValueOf(&n.Data).Elem(), planOf[T]().bytesEncoding); ok {
+		n.Valid = err == nil
+		return err
 	}
 
 	var ref T
 	return fmt.Errorf("type %T unmarshal", ref)
 }
 
-func unmarshalTextBool(str string, b *Nullable[bool]) error {
-	switch str {
-	case "", "null":
-		b.Valid = false
-		return nil
-	case "true":
-		b.Data = true
-	case "false":
-		b.Data = false
+// unmarshalTextKind Parse text into a value with a basic kind, like a named string or integer type.
+// Byte slices are decoded with encoding. Returns false if the kind of dest is not supported
+func unmarshalTextKind(str string, dest reflect.Value, encoding BytesEncoding) (bool, error) {
+	var err error
+	switch dest.Kind() {
+	case reflect.String:
+		dest.SetString(str)
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(str); err == nil {
+			dest.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, dest.Type().Bits()); err == nil {
+			dest.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if u, err = strconv.ParseUint(str, 10, dest.Type().Bits()); err == nil {
+			dest.SetUint(u)
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(str, dest.Type().Bits()); err == nil {
+			dest.SetFloat(f)
+		}
+	case reflect.Slice:
+		if dest.Type().Elem().Kind() != reflect.Uint8 {
+			return false, nil
+		}
+		var b []byte
+		if b, err = encoding.decode(str); err == nil {
+			dest.SetBytes(b)
+		}
 	default:
-		return errors.New("null: invalid input for UnmarshalText:" + str)
-	}
-	b.Valid = true
-	return nil
-}
-
-func unmarshalTextFloat[T any](str string, f *Nullable[T]) error {
-	if str == "" || str == "null" {
-		f.Valid = false
-		return nil
+		return false, nil
 	}
-
-	var size int
-	v := any(f.Data)
-	switch v.(type) {
-	case float32:
-		size = 32
-	case float64:
-		size = 64
-	}
-
-	n, err := strconv.ParseFloat(str, size)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
-	}
-
-	switch v.(type) {
-	case float32:
-		f.Data = any(float32(n)).(T)
-	case float64:
-		f.Data = any(n).(T)
+		return true, fmt.Errorf("null: couldn't unmarshal text: %w", err)
 	}
-
-	f.Valid = true
-	return err
+	return true, nil
 }
 
-func unmarshalTextInt[T any](str string, f *Nullable[T]) error {
-	if str == "" || str == "null" {
-		f.Valid = false
-		return nil
-	}
-
-	var size int
-	v := any(f.Data)
-	switch v.(type) {
-	case int8:
-		size = 8
-	case int16:
-		size = 16
-	case int32, int:
-		size = 32
-	case int64:
-		size = 64
+// unmarshalTextBuiltIn Parse text into dest, a pointer to a built-in type, without going through reflection.
+// dest is only set if the text is valid. Returns false if dest is not one of them
+func unmarshalTextBuiltIn(str string, dest any) (bool, error) {
+	var err error
+	switch d := dest.(type) {
+	case *string:
+		*d = str
+	case *json.RawMessage:
+		*d = json.RawMessage(str)
+	case *bool:
+		switch str {
+		case "true":
+			*d = true
+		case "false":
+			*d = false
+		default:
+			return true, errors.New("null: invalid input for UnmarshalText:" + str)
+		}
+	case *float64:
+		var f float64
+		if f, err = strconv.ParseFloat(str, 64); err == nil {
+			*d = f
+		}
+	case *float32:
+		var f float64
+		if f, err = strconv.ParseFloat(str, 32); err == nil {
+			*d = float32(f)
+		}
+	case *int:
+		var i int
+		if i, err = strconv.Atoi(str); err == nil {
+			*d = i
+		}
+	case *int8:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, 8); err == nil {
+			*d = int8(i)
+		}
+	case *int16:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, 16); err == nil {
+			*d = int16(i)
+		}
+	case *int32:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, 32); err == nil {
+			*d = int32(i)
+		}
+	case *int64:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, 64); err == nil {
+			*d = i
+		}
+	default:
+		return false, nil
 	}
-
-	n, err := strconv.ParseInt(str, 10, size)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
-	}
-
-	switch v.(type) {
-	case int8:
-		f.Data = any(int8(n)).(T)
-	case int16:
-		f.Data = any(int16(n)).(T)
-	case int32:
-		f.Data = any(int32(n)).(T)
-	case int:
-		f.Data = any(int(n)).(T)
-	case int64:
-		f.Data = any(n).(T)
+		return true, fmt.Errorf("null: couldn't unmarshal text: %w", err)
 	}
-
-	f.Valid = true
-	return err
+	return true, nil
 }
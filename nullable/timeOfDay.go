@@ -0,0 +1,162 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a time of day without date or time zone, like the SQL TIME type.
+// It is written as 15:04:05, with fractional seconds when needed, in JSON, text and SQL.
+// Like in PostgreSQL, 24:00:00 is the end of the day, after 23:59:59.999999999
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf Get the time of day of t in its location
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay Parse a time of day in the format 15:04, 15:04:05 or 15:04:05.999999999
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	invalid := errors.New("null: could not parse time of day: " + s)
+
+	clock, fraction, hasFraction := strings.Cut(s, ".")
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 || (hasFraction && len(parts) != 3) {
+		return TimeOfDay{}, invalid
+	}
+
+	var values [3]int
+	for i, part := range parts {
+		if len(part) != 2 || !isDigits(part) {
+			return TimeOfDay{}, invalid
+		}
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return TimeOfDay{}, invalid
+		}
+		values[i] = value
+	}
+
+	tod := TimeOfDay{Hour: values[0], Minute: values[1], Second: values[2]}
+	if hasFraction {
+		if fraction == "" || len(fraction) > 9 || !isDigits(fraction) {
+			return TimeOfDay{}, invalid
+		}
+		nanos, err := strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+		if err != nil {
+			return TimeOfDay{}, invalid
+		}
+		tod.Nanosecond = nanos
+	}
+	if !tod.IsValid() {
+		return TimeOfDay{}, invalid
+	}
+	return tod, nil
+}
+
+// isDigits Check if s only has the digits 0-9, without a sign like strconv.Atoi accepts
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String Format the time of day as 15:04:05, adding fractional seconds when they are not zero
+func (t TimeOfDay) String() string {
+	str := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		str += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	return str
+}
+
+// IsValid Check if all fields are within their range, or if it is 24:00:00
+func (t TimeOfDay) IsValid() bool {
+	if t == (TimeOfDay{Hour: 24}) {
+		return true
+	}
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// SinceMidnight Get the duration from midnight to the time of day
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+// Add Get the time of day d after t, wrapping around midnight. The result is never 24:00:00
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	const day = 24 * time.Hour
+	since := (t.SinceMidnight() + d%day + day) % day
+	return TimeOfDay{
+		Hour:       int(since / time.Hour),
+		Minute:     int(since % time.Hour / time.Minute),
+		Second:     int(since % time.Minute / time.Second),
+		Nanosecond: int(since % time.Second),
+	}
+}
+
+// Sub Get the duration from other to t on the same day
+func (t TimeOfDay) Sub(other TimeOfDay) time.Duration {
+	return t.SinceMidnight() - other.SinceMidnight()
+}
+
+// Compare Compare t to other, returning -1 if t is before other, 1 if it is after, and 0 if they are the same
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	switch d := t.Sub(other); {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
+
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	tod, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tod
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading a time.Time or a string in the format 15:04:05
+func (t *TimeOfDay) Scan(src any) error {
+	switch s := src.(type) {
+	case time.Time:
+		*t = TimeOfDayOf(s)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(s))
+	case []byte:
+		return t.UnmarshalText(s)
+	}
+	return fmt.Errorf("null: cannot scan %T into TimeOfDay", src)
+}
+
+// Value Implement driver.Valuer, writing the time of day as 15:04:05
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
@@ -0,0 +1,83 @@
+package nullable
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// TimeOptions are the settings of a time type, like time.Time, UnixSeconds, UnixMillis or a LayoutTime.
+// They are registered per type with RegisterTimeOptions, and types without options use DefaultTimeOptions.
+// The scan and value options are only used by Nullable[time.Time]
+type TimeOptions struct {
+	// Location is the location of times parsed from input without a time zone, like a Unix time. Nil is UTC
+	Location *time.Location
+
+	// ScanLayouts are the layouts tried in order when scanning a string, as returned by SQLite
+	// and by MySQL without parseTime
+	ScanLayouts []string
+	// ScanEpochUnit is the unit of scanned numbers, counted from the Unix epoch. It must divide a second,
+	// like time.Second or time.Millisecond. Zero does not accept numbers
+	ScanEpochUnit time.Duration
+
+	// ValueLocation is the location times are converted to by Value. Nil keeps the location
+	ValueLocation *time.Location
+	// ValuePrecision is the precision times are truncated to by Value, like time.Microsecond for PostgreSQL.
+	// Zero keeps the full precision
+	ValuePrecision time.Duration
+}
+
+var (
+	timeOptions        sync.Map
+	defaultTimeOptions = DefaultTimeOptions()
+)
+
+// DefaultTimeOptions Get the options used for time types without registered options.
+// Change the options it returns to register options that differ in only some fields
+func DefaultTimeOptions() TimeOptions {
+	return TimeOptions{
+		Location: time.UTC,
+		ScanLayouts: []string{
+			time.RFC3339Nano,
+			"2006-01-02 15:04:05.999999999Z07:00",
+			"2006-01-02 15:04:05.999999999Z07",
+			"2006-01-02 15:04:05.999999999",
+			"2006-01-02T15:04:05.999999999",
+			time.DateOnly,
+		},
+		ScanEpochUnit: time.Second,
+	}
+}
+
+// RegisterTimeOptions Use options when reading and writing Nullable[T] and T, instead of DefaultTimeOptions.
+// The returned function restores the options that were registered before, which is useful in tests
+func RegisterTimeOptions[T any](options TimeOptions) (restore func()) {
+	options.ScanLayouts = slices.Clone(options.ScanLayouts)
+	return registerCodec[T](&timeOptions, &options)
+}
+
+// timeOptionsOf Get the options of T
+func timeOptionsOf[T any]() *TimeOptions {
+	if options := planOf[T]().timeOptions; options != nil {
+		return options
+	}
+	return &defaultTimeOptions
+}
+
+func (o *TimeOptions) location() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// normalize Convert t to the ValueLocation and truncate it to the ValuePrecision
+func (o *TimeOptions) normalize(t time.Time) time.Time {
+	if o.ValueLocation != nil {
+		t = t.In(o.ValueLocation)
+	}
+	if o.ValuePrecision > 0 {
+		t = t.Truncate(o.ValuePrecision)
+	}
+	return t
+}
@@ -0,0 +1,62 @@
+package nullable
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeScanner scans a time.Time from a time.Time, a string with one of the ScanLayouts of the TimeOptions
+// of time.Time, or a Unix time
+type timeScanner struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (s *timeScanner) Scan(value any) error {
+	if value == nil {
+		s.Time, s.Valid = time.Time{}, false
+		return nil
+	}
+
+	options := timeOptionsOf[time.Time]()
+	var err error
+	switch v := value.(type) {
+	case time.Time:
+		s.Time = v
+	case string:
+		s.Time, err = parseScannedTime(v, options)
+	case []byte:
+		s.Time, err = parseScannedTime(string(v), options)
+	case int64:
+		if options.ScanEpochUnit == 0 {
+			return fmt.Errorf("null: cannot scan %T into time.Time", value)
+		}
+		s.Time = unixTime(v, options.ScanEpochUnit, options.location())
+	case float64:
+		if options.ScanEpochUnit == 0 {
+			return fmt.Errorf("null: cannot scan %T into time.Time", value)
+		}
+		s.Time, err = parseUnix(strconv.FormatFloat(v, 'f', -1, 64), options.ScanEpochUnit, options.location())
+	default:
+		return fmt.Errorf("null: cannot scan %T into time.Time", value)
+	}
+
+	s.Valid = err == nil
+	return err
+}
+
+func parseScannedTime(value string, options *TimeOptions) (time.Time, error) {
+	loc := options.location()
+	for _, layout := range options.ScanLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	if options.ScanEpochUnit != 0 {
+		if t, err := parseUnix(value, options.ScanEpochUnit, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("null: could not parse time %q", value)
+}
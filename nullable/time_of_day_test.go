@@ -0,0 +1,77 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_TimeOfDay_parse(t *testing.T) {
+	tod, err := ParseTimeOfDay("15:04:05")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeOfDay{15, 4, 5, 0}, tod)
+
+	tod, err = ParseTimeOfDay("15:04")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeOfDay{15, 4, 0, 0}, tod)
+
+	tod, err = ParseTimeOfDay("15:04:05.25")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeOfDay{15, 4, 5, 250000000}, tod)
+	assert.Equal(t, "15:04:05.25", tod.String())
+
+	tod, err = ParseTimeOfDay("24:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeOfDay{24, 0, 0, 0}, tod)
+	assert.Equal(t, "24:00:00", tod.String())
+	assert.Equal(t, 24*time.Hour, tod.SinceMidnight())
+	assert.Equal(t, 1, tod.Compare(TimeOfDay{23, 59, 59, 999999999}))
+	assert.Equal(t, TimeOfDay{0, 30, 0, 0}, tod.Add(30*time.Minute))
+
+	var n Nullable[TimeOfDay]
+	assert.NoError(t, n.Scan("24:00:00"))
+	assert.Equal(t, Value(TimeOfDay{Hour: 24}), n)
+
+	for _, invalid := range []string{"24:00:01", "24:00:00.5", "25:00", "15:60", "15", "1:04:05", "15:04:05.", "15:04.5", "15:04:05.1234567890",
+		"+1:30", "-1:30", "12:+5", "12:00:00.+5", "12:00:00.-5"} {
+		_, err = ParseTimeOfDay(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func Test_TimeOfDay_arithmetic(t *testing.T) {
+	tod := TimeOfDay{Hour: 23, Minute: 30}
+	assert.Equal(t, TimeOfDay{Hour: 0, Minute: 15}, tod.Add(45*time.Minute))
+	assert.Equal(t, TimeOfDay{Hour: 22, Minute: 30}, tod.Add(-time.Hour))
+	assert.Equal(t, TimeOfDay{Hour: 23, Minute: 30}, tod.Add(-48*time.Hour))
+	assert.Equal(t, 30*time.Minute, tod.Sub(TimeOfDay{Hour: 23}))
+	assert.Equal(t, 1, tod.Compare(TimeOfDay{Hour: 23}))
+	assert.Equal(t, TimeOfDay{21, 21, 21, 0}, TimeOfDayOf(timeValue1))
+}
+
+func Test_TimeOfDay_nullable(t *testing.T) {
+	tod := Value(TimeOfDay{Hour: 15, Minute: 4, Second: 5})
+	data, err := json.Marshal(tod)
+	assert.NoError(t, err)
+	assert.Equal(t, `"15:04:05"`, string(data))
+
+	var unmarshal Nullable[TimeOfDay]
+	assert.NoError(t, json.Unmarshal(data, &unmarshal))
+	assert.Equal(t, tod, unmarshal)
+
+	txt, err := tod.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "15:04:05", string(txt))
+
+	var scanned Nullable[TimeOfDay]
+	assert.NoError(t, scanned.Scan([]byte("15:04:05")))
+	assert.Equal(t, tod, scanned)
+	assert.NoError(t, scanned.Scan(time.Date(0, 1, 1, 15, 4, 5, 0, time.UTC)))
+	assert.Equal(t, tod, scanned)
+	assert.Error(t, scanned.Scan(1.5))
+
+	value, err := tod.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "15:04:05", value)
+}
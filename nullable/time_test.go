@@ -201,8 +201,96 @@ func Test_Time_Scan(t *testing.T) {
 	}
 
 	var wrong Nullable[time.Time]
-	err = wrong.Scan(int64(42))
+	err = wrong.Scan(true)
 	assert.NotNil(t, err)
+	assert.False(t, wrong.Valid)
+
+	var unparsable Nullable[time.Time]
+	err = unparsable.Scan("hello world")
+	assert.NotNil(t, err)
+	assert.False(t, unparsable.Valid)
+}
+
+func Test_Time_Scan_string(t *testing.T) {
+	for _, value := range []any{
+		"2012-12-21 21:21:21",
+		"2012-12-21T21:21:21",
+		"2012-12-21T21:21:21Z",
+		"2012-12-21 22:21:21+01:00",
+		"2012-12-21 21:21:21+00",
+		[]byte("2012-12-21 21:21:21.000"),
+	} {
+		var ti Nullable[time.Time]
+		err := ti.Scan(value)
+		assert.NoError(t, err, value)
+		assert.True(t, ti.Valid)
+		assert.True(t, timeValue1.Equal(ti.Data), value)
+	}
+
+	var date Nullable[time.Time]
+	assert.NoError(t, date.Scan("2012-12-21"))
+	assert.Equal(t, time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC), date.Data)
+}
+
+func Test_Time_Scan_layouts(t *testing.T) {
+	options := DefaultTimeOptions()
+	options.ScanLayouts = []string{"02.01.2006 15:04"}
+	options.Location = time.FixedZone("UTC+1", 3600)
+	restore := RegisterTimeOptions[time.Time](options)
+	defer restore()
+
+	// The options are copied when registered
+	options.ScanLayouts[0] = time.DateOnly
+
+	var ti Nullable[time.Time]
+	assert.NoError(t, ti.Scan("21.12.2012 22:21"))
+	assert.True(t, timeValue1.Add(-21*time.Second).Equal(ti.Data))
+
+	assert.Error(t, ti.Scan("2012-12-21 21:21:21"))
+}
+
+func Test_Time_Scan_epoch(t *testing.T) {
+	var ti Nullable[time.Time]
+	assert.NoError(t, ti.Scan(int64(1356124881)))
+	assertTime(t, ti, "scanned Unix seconds")
+
+	assert.NoError(t, ti.Scan(1356124881.0))
+	assertTime(t, ti, "scanned Unix seconds float")
+
+	assert.NoError(t, ti.Scan("1356124881"))
+	assertTime(t, ti, "scanned Unix seconds string")
+
+	options := DefaultTimeOptions()
+	options.ScanEpochUnit = time.Millisecond
+	restore := RegisterTimeOptions[time.Time](options)
+	defer restore()
+	assert.NoError(t, ti.Scan(int64(1356124881000)))
+	assertTime(t, ti, "scanned Unix milliseconds")
+
+	options.ScanEpochUnit = 0
+	RegisterTimeOptions[time.Time](options)
+	assert.Error(t, ti.Scan(int64(42)))
+	assert.False(t, ti.Valid)
+	assert.Error(t, ti.Scan("42"))
+}
+
+func Test_Time_Value_normalize(t *testing.T) {
+	ti := Value(timeValue2.Add(123456789 * time.Nanosecond))
+
+	options := DefaultTimeOptions()
+	options.ValueLocation = time.UTC
+	options.ValuePrecision = time.Microsecond
+	restore := RegisterTimeOptions[time.Time](options)
+	defer restore()
+
+	value, err := ti.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, timeValue1.Add(123456*time.Microsecond), value)
+
+	// Other time types are not normalized
+	value, err = Value(LayoutTime[DateTimeLayout]{timeValue2}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, timeValue2, value)
 }
 
 func Test_IsZero_time(t *testing.T) {
@@ -0,0 +1,149 @@
+package nullable
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// typePlan is how Nullable[T] reads and writes T, resolved once per type instead of on every call.
+// Plans are cached by reflect.Type, and resolved again when a registry changes
+type typePlan[T any] struct {
+	generation uint64
+
+	textCodec *textCodec[T]
+	sqlCodec  *sqlCodec[T]
+	jsonCodec *jsonCodec[T]
+	mapping   valueMapping
+	enum      enumInfo
+
+	floatFormat    *FloatFormat
+	jsonColumnNull JSONNullMode
+	timeOptions    *TimeOptions
+	uuidValue      UUIDValueFormat
+	bytesEncoding  BytesEncoding
+	nonFinite      NonFinitePolicy
+	int64JSON      Int64JSONFormat
+
+	scan scanPath
+}
+
+// scanPath is the way Scan reads T when there is no SQL codec or mapping
+type scanPath int
+
+const (
+	scanUnsupported scanPath = iota
+	scanWithScanner
+	scanWithArray
+	scanWithBuiltIn
+	scanWithText
+	scanWithJSON
+	scanWithKind
+)
+
+var (
+	typePlans sync.Map
+
+	// codecGeneration is increased by every registry change, making the cached plans stale
+	codecGeneration atomic.Uint64
+)
+
+// planOf Get the plan of T, resolving it if it is not cached or the registries changed since it was resolved
+func planOf[T any]() *typePlan[T] {
+	generation := codecGeneration.Load()
+	t := typeOf[T]()
+	if cached, ok := typePlans.Load(t); ok {
+		if plan := cached.(*typePlan[T]); plan.generation == generation {
+			return plan
+		}
+	}
+
+	plan := newTypePlan[T](t, generation)
+	typePlans.Store(t, plan)
+	return plan
+}
+
+// invalidateTypePlans Make all cached plans stale. Must be called after a registry has been changed
+func invalidateTypePlans() {
+	codecGeneration.Add(1)
+}
+
+func newTypePlan[T any](t reflect.Type, generation uint64) *typePlan[T] {
+	plan := &typePlan[T]{generation: generation, scan: resolveScanPath(t)}
+	if codec, ok := textCodecs.Load(t); ok {
+		plan.textCodec = codec.(*textCodec[T])
+	}
+	if codec, ok := sqlCodecs.Load(t); ok {
+		plan.sqlCodec = codec.(*sqlCodec[T])
+	}
+	if codec, ok := jsonCodecs.Load(t); ok {
+		plan.jsonCodec = codec.(*jsonCodec[T])
+	}
+	if mapping, ok := mappings.Load(t); ok {
+		plan.mapping = mapping.(valueMapping)
+	}
+	if enum, ok := enums.Load(t); ok {
+		plan.enum = enum.(enumInfo)
+	}
+	if format, ok := floatFormats.Load(t); ok {
+		plan.floatFormat = format.(*FloatFormat)
+	}
+	if mode, ok := jsonColumnNulls.Load(t); ok {
+		plan.jsonColumnNull = *mode.(*JSONNullMode)
+	}
+	if options, ok := timeOptions.Load(t); ok {
+		plan.timeOptions = options.(*TimeOptions)
+	}
+	if format, ok := uuidValueFormats.Load(t); ok {
+		plan.uuidValue = *format.(*UUIDValueFormat)
+	}
+	if encoding, ok := bytesEncodings.Load(t); ok {
+		plan.bytesEncoding = *encoding.(*BytesEncoding)
+	}
+	if policy, ok := nonFinitePolicies.Load(t); ok {
+		plan.nonFinite = *policy.(*NonFinitePolicy)
+	}
+	if format, ok := int64JSONFormats.Load(t); ok {
+		plan.int64JSON = *format.(*Int64JSONFormat)
+	}
+	return plan
+}
+
+func resolveScanPath(t reflect.Type) scanPath {
+	switch {
+	case reflect.PointerTo(t).Implements(scannerType):
+		return scanWithScanner
+	case isArrayType(t):
+		return scanWithArray
+	case isBuiltInScanType(t):
+		return scanWithBuiltIn
+	case isTextColumnType(t):
+		return scanWithText
+	case isJSONColumnType(t):
+		return scanWithJSON
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return scanWithKind
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return scanWithKind
+		}
+	}
+	return scanUnsupported
+}
+
+// isBuiltInScanType Check if t is one of the types handled by scanBuiltIn
+func isBuiltInScanType(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(""), reflect.TypeOf(false), reflect.TypeOf(float64(0)), reflect.TypeOf(float32(0)),
+		reflect.TypeOf(0), reflect.TypeOf(int16(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+		reflect.TypeOf(time.Time{}):
+		return true
+	}
+	return false
+}
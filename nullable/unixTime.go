@@ -0,0 +1,184 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnixSeconds is a time.Time written as the number of seconds since the Unix epoch in JSON, text and SQL.
+// Fractional seconds are accepted when parsing
+type UnixSeconds struct {
+	time.Time
+}
+
+// UnixMillis is a time.Time written as the number of milliseconds since the Unix epoch in JSON, text and SQL.
+// Fractional milliseconds are accepted when parsing
+type UnixMillis struct {
+	time.Time
+}
+
+func (u UnixSeconds) MarshalJSON() ([]byte, error) {
+	return u.MarshalText()
+}
+
+func (u *UnixSeconds) UnmarshalJSON(data []byte) error {
+	t, err := unmarshalUnixJSON(data, time.Second, timeOptionsOf[UnixSeconds]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+func (u UnixSeconds) MarshalText() ([]byte, error) {
+	return u.AppendText(nil)
+}
+
+// AppendText Implement encoding.TextAppender, instead of the method of the embedded time.Time
+func (u UnixSeconds) AppendText(b []byte) ([]byte, error) {
+	return strconv.AppendInt(b, u.Unix(), 10), nil
+}
+
+func (u *UnixSeconds) UnmarshalText(text []byte) error {
+	t, err := parseUnix(string(text), time.Second, timeOptionsOf[UnixSeconds]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading a time.Time or the number of seconds since the Unix epoch
+func (u *UnixSeconds) Scan(src any) error {
+	t, err := scanUnix(src, time.Second, timeOptionsOf[UnixSeconds]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+// Value Implement driver.Valuer, writing the number of seconds since the Unix epoch
+func (u UnixSeconds) Value() (driver.Value, error) {
+	return u.Unix(), nil
+}
+
+func (u UnixMillis) MarshalJSON() ([]byte, error) {
+	return u.MarshalText()
+}
+
+func (u *UnixMillis) UnmarshalJSON(data []byte) error {
+	t, err := unmarshalUnixJSON(data, time.Millisecond, timeOptionsOf[UnixMillis]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+func (u UnixMillis) MarshalText() ([]byte, error) {
+	return u.AppendText(nil)
+}
+
+// AppendText Implement encoding.TextAppender, instead of the method of the embedded time.Time
+func (u UnixMillis) AppendText(b []byte) ([]byte, error) {
+	return strconv.AppendInt(b, u.UnixMilli(), 10), nil
+}
+
+func (u *UnixMillis) UnmarshalText(text []byte) error {
+	t, err := parseUnix(string(text), time.Millisecond, timeOptionsOf[UnixMillis]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading a time.Time or the number of milliseconds since the Unix epoch
+func (u *UnixMillis) Scan(src any) error {
+	t, err := scanUnix(src, time.Millisecond, timeOptionsOf[UnixMillis]().location())
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+// Value Implement driver.Valuer, writing the number of milliseconds since the Unix epoch
+func (u UnixMillis) Value() (driver.Value, error) {
+	return u.UnixMilli(), nil
+}
+
+func unmarshalUnixJSON(data []byte, unit time.Duration, loc *time.Location) (time.Time, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return time.Time{}, fmt.Errorf("null: could not unmarshal Unix time: %w", err)
+		}
+		return parseUnix(str, unit, loc)
+	}
+
+	var number json.Number
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&number); err != nil {
+		return time.Time{}, fmt.Errorf("null: could not unmarshal Unix time: %w", err)
+	}
+	return parseUnix(number.String(), unit, loc)
+}
+
+// parseUnix Parse a number of units since the Unix epoch, which may have a fraction or an exponent, as a time in loc
+func parseUnix(str string, unit time.Duration, loc *time.Location) (time.Time, error) {
+	if whole, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return unixTime(whole, unit, loc), nil
+	}
+
+	number, ok := new(big.Rat).SetString(strings.TrimSpace(str))
+	if !ok || strings.ContainsAny(str, "/") {
+		return time.Time{}, errors.New("null: invalid Unix time: " + str)
+	}
+	nanos := new(big.Rat).Mul(number, new(big.Rat).SetInt64(int64(unit)))
+	quotient, remainder := new(big.Int).QuoRem(nanos.Num(), nanos.Denom(), new(big.Int))
+	if remainder.Sign() < 0 {
+		quotient.Sub(quotient, big.NewInt(1))
+	}
+	seconds, nanoseconds := new(big.Int).DivMod(quotient, big.NewInt(int64(time.Second)), new(big.Int))
+	if !seconds.IsInt64() {
+		return time.Time{}, errors.New("null: Unix time out of range: " + str)
+	}
+	return time.Unix(seconds.Int64(), nanoseconds.Int64()).In(loc), nil
+}
+
+func scanUnix(src any, unit time.Duration, loc *time.Location) (time.Time, error) {
+	switch s := src.(type) {
+	case time.Time:
+		return s, nil
+	case int64:
+		return unixTime(s, unit, loc), nil
+	case float64:
+		return parseUnix(strconv.FormatFloat(s, 'f', -1, 64), unit, loc)
+	case string:
+		return parseUnix(s, unit, loc)
+	case []byte:
+		return parseUnix(string(s), unit, loc)
+	}
+	return time.Time{}, fmt.Errorf("null: cannot scan %T into Unix time", src)
+}
+
+func unixTime(value int64, unit time.Duration, loc *time.Location) time.Time {
+	perSecond := int64(time.Second / unit)
+	seconds := value / perSecond
+	remainder := value % perSecond
+	if remainder < 0 {
+		seconds--
+		remainder += perSecond
+	}
+	return time.Unix(seconds, remainder*int64(unit)).In(loc)
+}
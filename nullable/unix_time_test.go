@@ -0,0 +1,165 @@
+package nullable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type partnerEvent struct {
+	Created Nullable[UnixSeconds] `json:"created"`
+	Updated Nullable[UnixMillis]  `json:"updated"`
+}
+
+func Test_UnixSeconds_json(t *testing.T) {
+	var event partnerEvent
+	err := json.Unmarshal([]byte(`{"created":1356124881,"updated":1356124881500}`), &event)
+	assert.NoError(t, err)
+	assert.True(t, event.Created.Valid)
+	assert.True(t, timeValue1.Equal(event.Created.Data.Time))
+	assert.True(t, timeValue1.Add(500*time.Millisecond).Equal(event.Updated.Data.Time))
+
+	data, err := json.Marshal(event)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"created":1356124881,"updated":1356124881500}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"created":"1356124881.25","updated":null}`), &event)
+	assert.NoError(t, err)
+	assert.True(t, timeValue1.Add(250*time.Millisecond).Equal(event.Created.Data.Time))
+	assert.False(t, event.Updated.Valid)
+
+	err = json.Unmarshal([]byte(`{"created":1.356124881e9}`), &event)
+	assert.NoError(t, err)
+	assert.True(t, timeValue1.Equal(event.Created.Data.Time))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"created":"yesterday"}`), &event))
+	assert.Error(t, json.Unmarshal([]byte(`{"created":true}`), &event))
+	assert.Error(t, json.Unmarshal([]byte(`{"created":"1/2"}`), &event))
+}
+
+func Test_UnixSeconds_negative(t *testing.T) {
+	var u UnixMillis
+	assert.NoError(t, u.UnmarshalText([]byte("-1500")))
+	assert.Equal(t, time.Unix(-2, 500*int64(time.Millisecond)).UTC(), u.Time)
+	txt, err := u.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "-1500", string(txt))
+}
+
+func Test_UnixSeconds_text(t *testing.T) {
+	n := Value(UnixSeconds{timeValue1})
+	txt, err := n.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1356124881", string(txt))
+
+	var unmarshal Nullable[UnixSeconds]
+	assert.NoError(t, unmarshal.UnmarshalText(txt))
+	assert.Equal(t, n, unmarshal)
+	assert.Equal(t, time.UTC, unmarshal.Data.Location())
+}
+
+func Test_UnixSeconds_scan(t *testing.T) {
+	var n Nullable[UnixSeconds]
+	assert.NoError(t, n.Scan(int64(1356124881)))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	assert.NoError(t, n.Scan([]byte("1356124881")))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	assert.NoError(t, n.Scan(1356124881.0))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	assert.NoError(t, n.Scan(timeValue1))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	assert.Error(t, n.Scan(true))
+	assert.False(t, n.Valid)
+
+	value, err := Value(UnixMillis{timeValue1}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1356124881000), value)
+}
+
+type stockholmDateTime struct{}
+
+func (stockholmDateTime) Layout() string {
+	return time.DateTime
+}
+
+func (stockholmDateTime) Location() *time.Location {
+	location, _ := time.LoadLocation("Europe/Stockholm")
+	return location
+}
+
+func Test_LayoutTime_json(t *testing.T) {
+	var n Nullable[LayoutTime[DateTimeLayout]]
+	assert.NoError(t, json.Unmarshal([]byte(`"2012-12-21 21:21:21"`), &n))
+	assert.True(t, n.Valid)
+	assert.Equal(t, timeValue1, n.Data.Time)
+
+	data, err := json.Marshal(Value(LayoutTime[DateTimeLayout]{timeValue2}))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2012-12-21 21:21:21"`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &n))
+	assert.Error(t, json.Unmarshal([]byte(`5`), &n))
+}
+
+func Test_LayoutTime_location(t *testing.T) {
+	if (stockholmDateTime{}).Location() == nil {
+		t.Skip("time zone database not available")
+	}
+
+	var n Nullable[LayoutTime[stockholmDateTime]]
+	assert.NoError(t, n.UnmarshalText([]byte("2012-12-21 22:21:21")))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	txt, err := Value(LayoutTime[stockholmDateTime]{timeValue1}).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2012-12-21 22:21:21", string(txt))
+}
+
+func Test_LayoutTime_default_location(t *testing.T) {
+	restore := RegisterTimeOptions[LayoutTime[DateTimeLayout]](TimeOptions{Location: time.FixedZone("UTC+1", 3600)})
+	defer restore()
+
+	parsed, err := ParseLayoutTime[DateTimeLayout]("2012-12-21 22:21:21")
+	assert.NoError(t, err)
+	assert.True(t, timeValue1.Equal(parsed.Time))
+
+	// Other types keep their own location
+	var unix UnixSeconds
+	assert.NoError(t, unix.UnmarshalText([]byte("1356124881")))
+	assert.Equal(t, time.UTC, unix.Location())
+}
+
+func Test_UnixSeconds_location(t *testing.T) {
+	utc1 := time.FixedZone("UTC+1", 3600)
+	restore := RegisterTimeOptions[UnixSeconds](TimeOptions{Location: utc1})
+	defer restore()
+
+	var n Nullable[UnixSeconds]
+	assert.NoError(t, n.UnmarshalJSON([]byte("1356124881")))
+	assert.Equal(t, utc1, n.Data.Location())
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	var millis UnixMillis
+	assert.NoError(t, millis.Scan(int64(1356124881000)))
+	assert.Equal(t, time.UTC, millis.Location())
+}
+
+func Test_LayoutTime_scan(t *testing.T) {
+	var n Nullable[LayoutTime[RFC1123Layout]]
+	assert.NoError(t, n.Scan("Fri, 21 Dec 2012 21:21:21 UTC"))
+	assert.True(t, timeValue1.Equal(n.Data.Time))
+
+	assert.NoError(t, n.Scan(timeValue1))
+	assert.Equal(t, timeValue1, n.Data.Time)
+
+	value, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, timeValue1, value)
+
+	assert.Error(t, n.Scan(int64(5)))
+}
@@ -0,0 +1,179 @@
+package nullable
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UUID is a universally unique identifier as defined by RFC 9562.
+// It is written in the canonical form, like 6ba7b810-9dad-11d1-80b4-00c04fd430c8, in JSON and text,
+// and scanned from both the text form and 16 raw bytes, like MySQL BINARY(16)
+type UUID [16]byte
+
+// UUIDValueFormat tells how Value writes a UUID to the database
+type UUIDValueFormat int
+
+const (
+	// UUIDAsString writes the canonical text form, for PostgreSQL uuid and text columns
+	UUIDAsString UUIDValueFormat = iota
+	// UUIDAsBytes writes the 16 raw bytes, for BINARY(16) columns
+	UUIDAsBytes
+)
+
+var uuidValueFormats sync.Map
+
+// RegisterUUIDValue Use format for Value of UUID, instead of UUIDAsString.
+// The returned function restores the format that was registered before, which is useful in tests
+func RegisterUUIDValue(format UUIDValueFormat) (restore func()) {
+	return registerCodec[UUID](&uuidValueFormats, &format)
+}
+
+// NewUUIDv4 Generate a random UUID, version 4
+func NewUUIDv4() (UUID, error) {
+	var u UUID
+	if _, err := rand.Read(u[:]); err != nil {
+		return UUID{}, fmt.Errorf("null: could not generate UUID: %w", err)
+	}
+	u.setVersion(4)
+	return u, nil
+}
+
+// uuidV7State is the last timestamp and counter of NewUUIDv7
+var uuidV7State struct {
+	sync.Mutex
+	millis  int64
+	counter uint16
+}
+
+// NewUUIDv7 Generate a UUID, version 7, starting with the current Unix time in milliseconds followed by
+// a 12-bit counter and random bits. The counter is increased within the same millisecond, as in RFC 9562
+// section 6.2, method 1, so UUIDs created later in the process sort after earlier ones
+func NewUUIDv7() (UUID, error) {
+	var u UUID
+	if _, err := rand.Read(u[6:]); err != nil {
+		return UUID{}, fmt.Errorf("null: could not generate UUID: %w", err)
+	}
+	// A new millisecond starts the counter at a random value with the top bit clear, leaving room to count
+	seed := binary.BigEndian.Uint16(u[6:8]) & 0x07ff
+
+	uuidV7State.Lock()
+	millis := time.Now().UnixMilli()
+	if millis > uuidV7State.millis {
+		uuidV7State.millis, uuidV7State.counter = millis, seed
+	} else if uuidV7State.counter++; uuidV7State.counter > 0x0fff {
+		// The counter overflowed, or the clock went back, so the timestamp is moved forward instead
+		uuidV7State.millis++
+		uuidV7State.counter = seed
+	}
+	millis, counter := uuidV7State.millis, uuidV7State.counter
+	uuidV7State.Unlock()
+
+	var timestamp [8]byte
+	binary.BigEndian.PutUint64(timestamp[:], uint64(millis))
+	copy(u[:6], timestamp[2:])
+	binary.BigEndian.PutUint16(u[6:8], counter)
+	u.setVersion(7)
+	return u, nil
+}
+
+// ParseUUID Parse a UUID in the canonical form, optionally in braces or with a urn:uuid: prefix,
+// or as 32 hexadecimal digits without hyphens. Upper and lower case are accepted
+func ParseUUID(s string) (UUID, error) {
+	str := s
+	if len(str) == 45 && strings.EqualFold(str[:9], "urn:uuid:") {
+		str = str[9:]
+	} else if len(str) == 38 && str[0] == '{' && str[37] == '}' {
+		str = str[1:37]
+	}
+
+	switch len(str) {
+	case 36:
+		if str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+			return UUID{}, errors.New("null: could not parse UUID: " + s)
+		}
+		str = str[:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	case 32:
+	default:
+		return UUID{}, errors.New("null: could not parse UUID: " + s)
+	}
+
+	var u UUID
+	if _, err := hex.Decode(u[:], []byte(str)); err != nil {
+		return UUID{}, errors.New("null: could not parse UUID: " + s)
+	}
+	return u, nil
+}
+
+// String Format the UUID in the canonical form with lower case hexadecimal digits
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Version Get the version of the UUID, like 4 for random UUIDs
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// IsZero Check if the UUID is the nil UUID with all bits zero
+func (u UUID) IsZero() bool {
+	return u == UUID{}
+}
+
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UUID) UnmarshalText(text []byte) error {
+	uuid, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = uuid
+	return nil
+}
+
+// Scan Implement sql.Scanner, reading the text form or 16 raw bytes
+func (u *UUID) Scan(src any) error {
+	switch s := src.(type) {
+	case string:
+		return u.UnmarshalText([]byte(s))
+	case []byte:
+		if len(s) == len(u) {
+			copy(u[:], s)
+			return nil
+		}
+		return u.UnmarshalText(s)
+	}
+	return fmt.Errorf("null: cannot scan %T into UUID", src)
+}
+
+// Value Implement driver.Valuer, writing the text form or the raw bytes depending on RegisterUUIDValue
+func (u UUID) Value() (driver.Value, error) {
+	if planOf[UUID]().uuidValue == UUIDAsBytes {
+		return u[:], nil
+	}
+	return u.String(), nil
+}
+
+func (u *UUID) setVersion(version byte) {
+	u[6] = u[6]&0x0f | version<<4
+	// The variant of RFC 9562 UUIDs is 10 in the two most significant bits
+	u[8] = u[8]&0x3f | 0x80
+}
@@ -0,0 +1,144 @@
+package nullable
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var testUUID = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+func Test_UUID_parse(t *testing.T) {
+	for _, str := range []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+	} {
+		u, err := ParseUUID(str)
+		assert.NoError(t, err, str)
+		assert.Equal(t, testUUID, u, str)
+	}
+
+	for _, invalid := range []string{"", "6ba7b810", "6ba7b810-9dad-11d1-80b4-00c04fd430cg", "6ba7b810x9dad-11d1-80b4-00c04fd430c8", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		_, err := ParseUUID(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", testUUID.String())
+	assert.Equal(t, 1, testUUID.Version())
+	assert.True(t, UUID{}.IsZero())
+}
+
+func Test_UUID_generate(t *testing.T) {
+	v4, err := NewUUIDv4()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, v4.Version())
+	assert.Equal(t, byte(0x80), v4[8]&0xc0)
+	other, _ := NewUUIDv4()
+	assert.NotEqual(t, v4, other)
+
+	before := time.Now().UnixMilli()
+	v7, err := NewUUIDv7()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, v7.Version())
+	assert.Equal(t, byte(0x80), v7[8]&0xc0)
+	millis := int64(v7[0])<<40 | int64(v7[1])<<32 | int64(v7[2])<<24 | int64(v7[3])<<16 | int64(v7[4])<<8 | int64(v7[5])
+	assert.GreaterOrEqual(t, millis, before)
+	assert.LessOrEqual(t, millis, time.Now().UnixMilli())
+}
+
+func Test_UUID_v7_ordered(t *testing.T) {
+	previous, err := NewUUIDv7()
+	assert.NoError(t, err)
+	for i := 0; i < 10000; i++ {
+		next, err := NewUUIDv7()
+		assert.NoError(t, err)
+		if bytes.Compare(previous[:], next[:]) >= 0 {
+			assert.Fail(t, "UUIDs are not ordered", "%s is not after %s", next, previous)
+			return
+		}
+		previous = next
+	}
+}
+
+func Test_UUID_Nullable_JSON(t *testing.T) {
+	type row struct {
+		Id       Nullable[UUID] `json:"id"`
+		ParentId Nullable[UUID] `json:"parent_id"`
+	}
+
+	data, err := json.Marshal(row{Id: Value(testUUID)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","parent_id":null}`, string(data))
+
+	var r row
+	assert.NoError(t, json.Unmarshal([]byte(`{"id":null,"parent_id":"6BA7B810-9DAD-11D1-80B4-00C04FD430C8"}`), &r))
+	assert.Equal(t, row{ParentId: Value(testUUID)}, r)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id":"nope"}`), &r))
+}
+
+func Test_UUID_Nullable_text(t *testing.T) {
+	var n Nullable[UUID]
+	assert.NoError(t, n.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")))
+	assert.Equal(t, Value(testUUID), n)
+
+	text, err := n.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", string(text))
+
+	assert.NoError(t, n.UnmarshalText([]byte("")))
+	assert.False(t, n.Valid)
+}
+
+func Test_UUID_Nullable_Scan(t *testing.T) {
+	for _, src := range []any{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		testUUID[:],
+	} {
+		var n Nullable[UUID]
+		assert.NoError(t, n.Scan(src), src)
+		assert.Equal(t, Value(testUUID), n)
+	}
+
+	var n Nullable[UUID]
+	assert.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	assert.Error(t, n.Scan(int64(1)))
+	assert.Error(t, n.Scan([]byte{1, 2, 3}))
+
+	// The raw bytes are copied, so the driver can reuse its buffer
+	raw := append([]byte{}, testUUID[:]...)
+	assert.NoError(t, n.Scan(raw))
+	raw[0] = 0
+	assert.Equal(t, testUUID, n.Data)
+}
+
+func Test_UUID_Nullable_Value(t *testing.T) {
+	value, err := Value(testUUID).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", value)
+
+	restore := RegisterUUIDValue(UUIDAsBytes)
+	defer restore()
+	value, err = Value(testUUID).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, testUUID[:], value)
+	value, err = testUUID.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, testUUID[:], value)
+
+	restore()
+	value, err = testUUID.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", value)
+
+	value, err = Null[UUID]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
@@ -1,10 +1,50 @@
 package nullable
 
-import "database/sql/driver"
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
 
 func (n Nullable[T]) Value() (driver.Value, error) {
 	if !n.Valid {
 		return nil, nil
 	}
+	if codec := lookupSQLCodec[T](); codec != nil {
+		return codec.encode(n.Data)
+	}
+	if mapping := lookupMapping[T](); mapping != nil {
+		return mapping.value(n.Data)
+	}
+	if f, ok := nonFiniteFloat(&n.Data); ok {
+		switch planOf[T]().nonFinite {
+		case NonFiniteAsNull:
+			return nil, nil
+		case NonFiniteAsError:
+			return nil, &NonFiniteError{Value: f}
+		}
+	}
+	if valuer, ok := any(n.Data).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	if t, ok := any(n.Data).(time.Time); ok {
+		return timeOptionsOf[time.Time]().normalize(t), nil
+	}
+	if isArrayType(typeOf[T]()) {
+		return reflectArrayValue(reflect.ValueOf(n.Data))
+	}
+	if isTextColumnType(typeOf[T]()) {
+		return n.textColumnValue()
+	}
+	if isJSONColumnType(typeOf[T]()) {
+		return n.jsonColumnValue()
+	}
+	if value := reflect.ValueOf(n.Data); value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		// A valid empty blob must not be written as NULL, and named types like json.RawMessage are no driver.Value
+		if b := value.Bytes(); b != nil {
+			return b, nil
+		}
+		return []byte{}, nil
+	}
 	return n.Data, nil
 }